@@ -0,0 +1,38 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/authenticator_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_AuthenticatorAccess = guard.Service{
+	Name: "AuthenticatorAccess",
+	Rules: []*guard.Rule{
+		{
+			RequireAuthentication: guard.Ptr(true),
+		},
+	},
+	Authenticators: []string{"user"},
+	Methods: map[string]*guard.Method{
+		"GetStatus": {
+			Rules: []*guard.Rule{
+				{
+					Condition: guard.Ptr("subject.scheme == 'service' || 'admin' in subject.roles"),
+				},
+			},
+			Authenticators: []string{"service", "user"},
+		},
+		"SyncUsers": {
+			Authenticators: []string{"service"},
+		},
+	},
+}
+
+func (UnimplementedAuthenticatorAccessServer) GuardService() *guard.Service {
+	return &guardService_AuthenticatorAccess
+}
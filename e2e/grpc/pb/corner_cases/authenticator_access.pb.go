@@ -0,0 +1,101 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.32.0
+// source: e2e/grpc/api/corner_cases/authenticator_access.proto
+
+package corner_cases
+
+import (
+	_ "github.com/casnerano/protoc-gen-go-guard/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_e2e_grpc_api_corner_cases_authenticator_access_proto protoreflect.FileDescriptor
+
+var file_e2e_grpc_api_corner_cases_authenticator_access_proto_rawDesc = []byte{
+	0x0a, 0x34, 0x65, 0x32, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x65, 0x32, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x6e,
+	0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc3, 0x02, 0x0a, 0x13, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x3e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x4a, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0d, 0xea,
+	0xb5, 0x18, 0x09, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x50, 0x92, 0xb5, 0x18, 0x39,
+	0x22, 0x37, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x20, 0x3d, 0x3d, 0x20, 0x27, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x27, 0x20, 0x7c, 0x7c,
+	0x20, 0x27, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x27, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0xea, 0xb5, 0x18, 0x0f, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x10, 0x8a, 0xb5,
+	0x18, 0x02, 0x10, 0x01, 0xe2, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x42, 0x43,
+	0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73,
+	0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x65, 0x32, 0x65, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61,
+	0x73, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_e2e_grpc_api_corner_cases_authenticator_access_proto_goTypes = []interface{}{
+	(*emptypb.Empty)(nil), // 0: google.protobuf.Empty
+}
+var file_e2e_grpc_api_corner_cases_authenticator_access_proto_depIdxs = []int32{
+	0, // 0: e2e.corner_cases.AuthenticatorAccess.GetProfile:input_type -> google.protobuf.Empty
+	0, // 1: e2e.corner_cases.AuthenticatorAccess.SyncUsers:input_type -> google.protobuf.Empty
+	0, // 2: e2e.corner_cases.AuthenticatorAccess.GetStatus:input_type -> google.protobuf.Empty
+	0, // 3: e2e.corner_cases.AuthenticatorAccess.GetProfile:output_type -> google.protobuf.Empty
+	0, // 4: e2e.corner_cases.AuthenticatorAccess.SyncUsers:output_type -> google.protobuf.Empty
+	0, // 5: e2e.corner_cases.AuthenticatorAccess.GetStatus:output_type -> google.protobuf.Empty
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_e2e_grpc_api_corner_cases_authenticator_access_proto_init() }
+func file_e2e_grpc_api_corner_cases_authenticator_access_proto_init() {
+	if File_e2e_grpc_api_corner_cases_authenticator_access_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_e2e_grpc_api_corner_cases_authenticator_access_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_e2e_grpc_api_corner_cases_authenticator_access_proto_goTypes,
+		DependencyIndexes: file_e2e_grpc_api_corner_cases_authenticator_access_proto_depIdxs,
+	}.Build()
+	File_e2e_grpc_api_corner_cases_authenticator_access_proto = out.File
+	file_e2e_grpc_api_corner_cases_authenticator_access_proto_rawDesc = nil
+	file_e2e_grpc_api_corner_cases_authenticator_access_proto_goTypes = nil
+	file_e2e_grpc_api_corner_cases_authenticator_access_proto_depIdxs = nil
+}
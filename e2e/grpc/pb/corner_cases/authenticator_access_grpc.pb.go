@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/authenticator_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AuthenticatorAccessClient is the client API for AuthenticatorAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthenticatorAccessClient interface {
+	// Access for authenticated end users only.
+	GetProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Access for internal services only.
+	SyncUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Access for internal services and admins.
+	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type authenticatorAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthenticatorAccessClient(cc grpc.ClientConnInterface) AuthenticatorAccessClient {
+	return &authenticatorAccessClient{cc}
+}
+
+func (c *authenticatorAccessClient) GetProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.AuthenticatorAccess/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorAccessClient) SyncUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.AuthenticatorAccess/SyncUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorAccessClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.AuthenticatorAccess/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthenticatorAccessServer is the server API for AuthenticatorAccess service.
+// All implementations must embed UnimplementedAuthenticatorAccessServer
+// for forward compatibility
+type AuthenticatorAccessServer interface {
+	// Access for authenticated end users only.
+	GetProfile(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Access for internal services only.
+	SyncUsers(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Access for internal services and admins.
+	GetStatus(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAuthenticatorAccessServer()
+}
+
+// UnimplementedAuthenticatorAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthenticatorAccessServer struct {
+}
+
+func (UnimplementedAuthenticatorAccessServer) GetProfile(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedAuthenticatorAccessServer) SyncUsers(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncUsers not implemented")
+}
+func (UnimplementedAuthenticatorAccessServer) GetStatus(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedAuthenticatorAccessServer) mustEmbedUnimplementedAuthenticatorAccessServer() {}
+
+// UnsafeAuthenticatorAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthenticatorAccessServer will
+// result in compilation errors.
+type UnsafeAuthenticatorAccessServer interface {
+	mustEmbedUnimplementedAuthenticatorAccessServer()
+}
+
+func RegisterAuthenticatorAccessServer(s grpc.ServiceRegistrar, srv AuthenticatorAccessServer) {
+	s.RegisterService(&AuthenticatorAccess_ServiceDesc, srv)
+}
+
+func _AuthenticatorAccess_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorAccessServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.AuthenticatorAccess/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorAccessServer).GetProfile(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticatorAccess_SyncUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorAccessServer).SyncUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.AuthenticatorAccess/SyncUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorAccessServer).SyncUsers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticatorAccess_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorAccessServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.AuthenticatorAccess/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorAccessServer).GetStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthenticatorAccess_ServiceDesc is the grpc.ServiceDesc for AuthenticatorAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthenticatorAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.AuthenticatorAccess",
+	HandlerType: (*AuthenticatorAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfile",
+			Handler:    _AuthenticatorAccess_GetProfile_Handler,
+		},
+		{
+			MethodName: "SyncUsers",
+			Handler:    _AuthenticatorAccess_SyncUsers_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _AuthenticatorAccess_GetStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/authenticator_access.proto",
+}
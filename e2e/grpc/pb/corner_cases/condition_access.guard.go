@@ -0,0 +1,35 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/condition_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_ConditionAccess = guard.Service{
+	Name: "ConditionAccess",
+	Methods: map[string]*guard.Method{
+		"AdminOrSupport": {
+			Rules: []*guard.Rule{
+				{
+					Condition: guard.Ptr("'admin' in subject.roles || 'x-support' in metadata"),
+				},
+			},
+		},
+		"SameTenant": {
+			Rules: []*guard.Rule{
+				{
+					Condition: guard.Ptr("subject.attrs.tenant_id == request.tenant_id"),
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedConditionAccessServer) GuardService() *guard.Service {
+	return &guardService_ConditionAccess
+}
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.32.0
+// source: e2e/grpc/api/corner_cases/condition_access.proto
+
+package corner_cases
+
+import (
+	_ "github.com/casnerano/protoc-gen-go-guard/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *TenantRequest) Reset() {
+	*x = TenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_e2e_grpc_api_corner_cases_condition_access_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantRequest) ProtoMessage() {}
+
+func (x *TenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_e2e_grpc_api_corner_cases_condition_access_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantRequest.ProtoReflect.Descriptor instead.
+func (*TenantRequest) Descriptor() ([]byte, []int) {
+	return file_e2e_grpc_api_corner_cases_condition_access_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+var File_e2e_grpc_api_corner_cases_condition_access_proto protoreflect.FileDescriptor
+
+var file_e2e_grpc_api_corner_cases_condition_access_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x65, 0x32, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x10, 0x65, 0x32, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63,
+	0x61, 0x73, 0x65, 0x73, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2c, 0x0a, 0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x32, 0x89, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x79, 0x0a, 0x0a, 0x53, 0x61, 0x6d, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x6e, 0x65,
+	0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x32, 0x92,
+	0xb5, 0x18, 0x2e, 0x22, 0x2c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x74, 0x74,
+	0x72, 0x73, 0x2e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x20, 0x3d, 0x3d, 0x20,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x12, 0x7b, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x72, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x39, 0x92, 0xb5, 0x18, 0x35, 0x22, 0x33, 0x27, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x27, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x20, 0x7c, 0x7c, 0x20, 0x27, 0x78, 0x2d, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x27, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x43,
+	0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73,
+	0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x65, 0x32, 0x65, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61,
+	0x73, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_e2e_grpc_api_corner_cases_condition_access_proto_rawDescOnce sync.Once
+	file_e2e_grpc_api_corner_cases_condition_access_proto_rawDescData = file_e2e_grpc_api_corner_cases_condition_access_proto_rawDesc
+)
+
+func file_e2e_grpc_api_corner_cases_condition_access_proto_rawDescGZIP() []byte {
+	file_e2e_grpc_api_corner_cases_condition_access_proto_rawDescOnce.Do(func() {
+		file_e2e_grpc_api_corner_cases_condition_access_proto_rawDescData = protoimpl.X.CompressGZIP(file_e2e_grpc_api_corner_cases_condition_access_proto_rawDescData)
+	})
+	return file_e2e_grpc_api_corner_cases_condition_access_proto_rawDescData
+}
+
+var file_e2e_grpc_api_corner_cases_condition_access_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_e2e_grpc_api_corner_cases_condition_access_proto_goTypes = []interface{}{
+	(*TenantRequest)(nil), // 0: e2e.corner_cases.TenantRequest
+	(*emptypb.Empty)(nil), // 1: google.protobuf.Empty
+}
+var file_e2e_grpc_api_corner_cases_condition_access_proto_depIdxs = []int32{
+	0, // 0: e2e.corner_cases.ConditionAccess.SameTenant:input_type -> e2e.corner_cases.TenantRequest
+	1, // 1: e2e.corner_cases.ConditionAccess.AdminOrSupport:input_type -> google.protobuf.Empty
+	1, // 2: e2e.corner_cases.ConditionAccess.SameTenant:output_type -> google.protobuf.Empty
+	1, // 3: e2e.corner_cases.ConditionAccess.AdminOrSupport:output_type -> google.protobuf.Empty
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_e2e_grpc_api_corner_cases_condition_access_proto_init() }
+func file_e2e_grpc_api_corner_cases_condition_access_proto_init() {
+	if File_e2e_grpc_api_corner_cases_condition_access_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_e2e_grpc_api_corner_cases_condition_access_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_e2e_grpc_api_corner_cases_condition_access_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_e2e_grpc_api_corner_cases_condition_access_proto_goTypes,
+		DependencyIndexes: file_e2e_grpc_api_corner_cases_condition_access_proto_depIdxs,
+		MessageInfos:      file_e2e_grpc_api_corner_cases_condition_access_proto_msgTypes,
+	}.Build()
+	File_e2e_grpc_api_corner_cases_condition_access_proto = out.File
+	file_e2e_grpc_api_corner_cases_condition_access_proto_rawDesc = nil
+	file_e2e_grpc_api_corner_cases_condition_access_proto_goTypes = nil
+	file_e2e_grpc_api_corner_cases_condition_access_proto_depIdxs = nil
+}
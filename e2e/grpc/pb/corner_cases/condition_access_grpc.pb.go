@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/condition_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ConditionAccessClient is the client API for ConditionAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConditionAccessClient interface {
+	// Allow only when the subject belongs to the requested tenant.
+	SameTenant(ctx context.Context, in *TenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Allow only for subjects with admin role or requests from support tooling.
+	AdminOrSupport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type conditionAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConditionAccessClient(cc grpc.ClientConnInterface) ConditionAccessClient {
+	return &conditionAccessClient{cc}
+}
+
+func (c *conditionAccessClient) SameTenant(ctx context.Context, in *TenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.ConditionAccess/SameTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conditionAccessClient) AdminOrSupport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.ConditionAccess/AdminOrSupport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConditionAccessServer is the server API for ConditionAccess service.
+// All implementations must embed UnimplementedConditionAccessServer
+// for forward compatibility
+type ConditionAccessServer interface {
+	// Allow only when the subject belongs to the requested tenant.
+	SameTenant(context.Context, *TenantRequest) (*emptypb.Empty, error)
+	// Allow only for subjects with admin role or requests from support tooling.
+	AdminOrSupport(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedConditionAccessServer()
+}
+
+// UnimplementedConditionAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedConditionAccessServer struct {
+}
+
+func (UnimplementedConditionAccessServer) SameTenant(context.Context, *TenantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SameTenant not implemented")
+}
+func (UnimplementedConditionAccessServer) AdminOrSupport(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminOrSupport not implemented")
+}
+func (UnimplementedConditionAccessServer) mustEmbedUnimplementedConditionAccessServer() {}
+
+// UnsafeConditionAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConditionAccessServer will
+// result in compilation errors.
+type UnsafeConditionAccessServer interface {
+	mustEmbedUnimplementedConditionAccessServer()
+}
+
+func RegisterConditionAccessServer(s grpc.ServiceRegistrar, srv ConditionAccessServer) {
+	s.RegisterService(&ConditionAccess_ServiceDesc, srv)
+}
+
+func _ConditionAccess_SameTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConditionAccessServer).SameTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.ConditionAccess/SameTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConditionAccessServer).SameTenant(ctx, req.(*TenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConditionAccess_AdminOrSupport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConditionAccessServer).AdminOrSupport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.ConditionAccess/AdminOrSupport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConditionAccessServer).AdminOrSupport(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConditionAccess_ServiceDesc is the grpc.ServiceDesc for ConditionAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConditionAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.ConditionAccess",
+	HandlerType: (*ConditionAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SameTenant",
+			Handler:    _ConditionAccess_SameTenant_Handler,
+		},
+		{
+			MethodName: "AdminOrSupport",
+			Handler:    _ConditionAccess_AdminOrSupport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/condition_access.proto",
+}
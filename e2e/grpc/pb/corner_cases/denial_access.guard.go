@@ -0,0 +1,55 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/denial_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_DenialAccess = guard.Service{
+	Name: "DenialAccess",
+	Methods: map[string]*guard.Method{
+		"Hidden": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"admin"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+			},
+			Denial: &guard.Denial{
+				Code: 5,
+			},
+		},
+		"Premium": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"premium"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+					Denial: &guard.Denial{
+						Code:    9,
+						Message: "upgrade required",
+					},
+				},
+			},
+			Denial: &guard.Denial{
+				Code: 5,
+			},
+		},
+	},
+}
+
+func (UnimplementedDenialAccessServer) GuardService() *guard.Service {
+	return &guardService_DenialAccess
+}
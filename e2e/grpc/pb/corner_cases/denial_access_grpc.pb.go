@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/denial_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DenialAccessClient is the client API for DenialAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DenialAccessClient interface {
+	// Admin method hidden from everyone else.
+	Hidden(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Premium feature with a rule-specific denial.
+	Premium(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type denialAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDenialAccessClient(cc grpc.ClientConnInterface) DenialAccessClient {
+	return &denialAccessClient{cc}
+}
+
+func (c *denialAccessClient) Hidden(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.DenialAccess/Hidden", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *denialAccessClient) Premium(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.DenialAccess/Premium", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DenialAccessServer is the server API for DenialAccess service.
+// All implementations must embed UnimplementedDenialAccessServer
+// for forward compatibility
+type DenialAccessServer interface {
+	// Admin method hidden from everyone else.
+	Hidden(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Premium feature with a rule-specific denial.
+	Premium(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedDenialAccessServer()
+}
+
+// UnimplementedDenialAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedDenialAccessServer struct {
+}
+
+func (UnimplementedDenialAccessServer) Hidden(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hidden not implemented")
+}
+func (UnimplementedDenialAccessServer) Premium(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Premium not implemented")
+}
+func (UnimplementedDenialAccessServer) mustEmbedUnimplementedDenialAccessServer() {}
+
+// UnsafeDenialAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DenialAccessServer will
+// result in compilation errors.
+type UnsafeDenialAccessServer interface {
+	mustEmbedUnimplementedDenialAccessServer()
+}
+
+func RegisterDenialAccessServer(s grpc.ServiceRegistrar, srv DenialAccessServer) {
+	s.RegisterService(&DenialAccess_ServiceDesc, srv)
+}
+
+func _DenialAccess_Hidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DenialAccessServer).Hidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.DenialAccess/Hidden",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DenialAccessServer).Hidden(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DenialAccess_Premium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DenialAccessServer).Premium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.DenialAccess/Premium",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DenialAccessServer).Premium(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DenialAccess_ServiceDesc is the grpc.ServiceDesc for DenialAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DenialAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.DenialAccess",
+	HandlerType: (*DenialAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Hidden",
+			Handler:    _DenialAccess_Hidden_Handler,
+		},
+		{
+			MethodName: "Premium",
+			Handler:    _DenialAccess_Premium_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/denial_access.proto",
+}
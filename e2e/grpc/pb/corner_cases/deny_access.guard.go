@@ -0,0 +1,55 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/deny_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_DenyAccess = guard.Service{
+	Name: "DenyAccess",
+	Rules: []*guard.Rule{
+		{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{
+					Roles:       []string{"user"},
+					Requirement: guard.Requirement(0),
+				},
+			},
+		},
+	},
+	DenyRules: []*guard.Rule{
+		{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{
+					Roles:       []string{"suspended"},
+					Requirement: guard.Requirement(0),
+				},
+			},
+		},
+	},
+	Methods: map[string]*guard.Method{
+		"Locked": {
+			DenyRules: []*guard.Rule{
+				{
+					AllowPublic: guard.Ptr(true),
+				},
+			},
+		},
+		"Public": {
+			Rules: []*guard.Rule{
+				{
+					AllowPublic: guard.Ptr(true),
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedDenyAccessServer) GuardService() *guard.Service {
+	return &guardService_DenyAccess
+}
@@ -0,0 +1,95 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.32.0
+// source: e2e/grpc/api/corner_cases/deny_access.proto
+
+package corner_cases
+
+import (
+	_ "github.com/casnerano/protoc-gen-go-guard/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_e2e_grpc_api_corner_cases_deny_access_proto protoreflect.FileDescriptor
+
+var file_e2e_grpc_api_corner_cases_deny_access_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x65, 0x32, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x64, 0x65, 0x6e, 0x79,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x65,
+	0x32, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32,
+	0xeb, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6e, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36,
+	0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x06, 0x92, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x40, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x06, 0xba, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x1a, 0x21, 0x8a, 0xb5, 0x18, 0x0a,
+	0x1a, 0x08, 0x0a, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0xb2, 0xb5, 0x18, 0x0f, 0x1a, 0x0d,
+	0x0a, 0x0b, 0x0a, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x43, 0x5a,
+	0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73, 0x6e,
+	0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
+	0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x65, 0x32, 0x65, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73,
+	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_e2e_grpc_api_corner_cases_deny_access_proto_goTypes = []interface{}{
+	(*emptypb.Empty)(nil), // 0: google.protobuf.Empty
+}
+var file_e2e_grpc_api_corner_cases_deny_access_proto_depIdxs = []int32{
+	0, // 0: e2e.corner_cases.DenyAccess.Read:input_type -> google.protobuf.Empty
+	0, // 1: e2e.corner_cases.DenyAccess.Public:input_type -> google.protobuf.Empty
+	0, // 2: e2e.corner_cases.DenyAccess.Locked:input_type -> google.protobuf.Empty
+	0, // 3: e2e.corner_cases.DenyAccess.Read:output_type -> google.protobuf.Empty
+	0, // 4: e2e.corner_cases.DenyAccess.Public:output_type -> google.protobuf.Empty
+	0, // 5: e2e.corner_cases.DenyAccess.Locked:output_type -> google.protobuf.Empty
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_e2e_grpc_api_corner_cases_deny_access_proto_init() }
+func file_e2e_grpc_api_corner_cases_deny_access_proto_init() {
+	if File_e2e_grpc_api_corner_cases_deny_access_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_e2e_grpc_api_corner_cases_deny_access_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_e2e_grpc_api_corner_cases_deny_access_proto_goTypes,
+		DependencyIndexes: file_e2e_grpc_api_corner_cases_deny_access_proto_depIdxs,
+	}.Build()
+	File_e2e_grpc_api_corner_cases_deny_access_proto = out.File
+	file_e2e_grpc_api_corner_cases_deny_access_proto_rawDesc = nil
+	file_e2e_grpc_api_corner_cases_deny_access_proto_goTypes = nil
+	file_e2e_grpc_api_corner_cases_deny_access_proto_depIdxs = nil
+}
@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/deny_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DenyAccessClient is the client API for DenyAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DenyAccessClient interface {
+	// Inherits service rules and service deny rules.
+	Read(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Public method still denied to suspended subjects.
+	Public(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Locked for everyone, in addition to the service deny rules.
+	Locked(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type denyAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDenyAccessClient(cc grpc.ClientConnInterface) DenyAccessClient {
+	return &denyAccessClient{cc}
+}
+
+func (c *denyAccessClient) Read(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.DenyAccess/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *denyAccessClient) Public(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.DenyAccess/Public", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *denyAccessClient) Locked(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.DenyAccess/Locked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DenyAccessServer is the server API for DenyAccess service.
+// All implementations must embed UnimplementedDenyAccessServer
+// for forward compatibility
+type DenyAccessServer interface {
+	// Inherits service rules and service deny rules.
+	Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Public method still denied to suspended subjects.
+	Public(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Locked for everyone, in addition to the service deny rules.
+	Locked(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedDenyAccessServer()
+}
+
+// UnimplementedDenyAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedDenyAccessServer struct {
+}
+
+func (UnimplementedDenyAccessServer) Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedDenyAccessServer) Public(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Public not implemented")
+}
+func (UnimplementedDenyAccessServer) Locked(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locked not implemented")
+}
+func (UnimplementedDenyAccessServer) mustEmbedUnimplementedDenyAccessServer() {}
+
+// UnsafeDenyAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DenyAccessServer will
+// result in compilation errors.
+type UnsafeDenyAccessServer interface {
+	mustEmbedUnimplementedDenyAccessServer()
+}
+
+func RegisterDenyAccessServer(s grpc.ServiceRegistrar, srv DenyAccessServer) {
+	s.RegisterService(&DenyAccess_ServiceDesc, srv)
+}
+
+func _DenyAccess_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DenyAccessServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.DenyAccess/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DenyAccessServer).Read(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DenyAccess_Public_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DenyAccessServer).Public(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.DenyAccess/Public",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DenyAccessServer).Public(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DenyAccess_Locked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DenyAccessServer).Locked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.DenyAccess/Locked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DenyAccessServer).Locked(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DenyAccess_ServiceDesc is the grpc.ServiceDesc for DenyAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DenyAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.DenyAccess",
+	HandlerType: (*DenyAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _DenyAccess_Read_Handler,
+		},
+		{
+			MethodName: "Public",
+			Handler:    _DenyAccess_Public_Handler,
+		},
+		{
+			MethodName: "Locked",
+			Handler:    _DenyAccess_Locked_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/deny_access.proto",
+}
@@ -0,0 +1,68 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/field_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_FieldAccess = guard.Service{
+	Name: "FieldAccess",
+	Rules: []*guard.Rule{
+		{
+			AllowPublic: guard.Ptr(true),
+		},
+	},
+	Methods: map[string]*guard.Method{},
+	Messages: map[string]*guard.Message{
+		"e2e.corner_cases.Billing": {
+			Fields: map[string]*guard.Field{
+				"card_last4": {
+					Rules: []*guard.Rule{
+						{
+							AuthenticatedAccess: &guard.AuthenticatedAccess{
+								RoleBased: &guard.RoleBased{
+									Roles:       []string{"billing"},
+									Requirement: guard.Requirement(0),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"e2e.corner_cases.Profile": {
+			Fields: map[string]*guard.Field{
+				"email": {
+					Rules: []*guard.Rule{
+						{
+							RequireAuthentication: guard.Ptr(true),
+						},
+					},
+				},
+				"role": {
+					WriteRules: []*guard.Rule{
+						{
+							AuthenticatedAccess: &guard.AuthenticatedAccess{
+								RoleBased: &guard.RoleBased{
+									Roles:       []string{"admin"},
+									Requirement: guard.Requirement(0),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"e2e.corner_cases.ProfileList":          {},
+		"e2e.corner_cases.UpdateProfileRequest": {},
+	},
+}
+
+func (UnimplementedFieldAccessServer) GuardService() *guard.Service {
+	return &guardService_FieldAccess
+}
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/field_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FieldAccessClient is the client API for FieldAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FieldAccessClient interface {
+	GetProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Profile, error)
+	ListProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProfileList, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type fieldAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFieldAccessClient(cc grpc.ClientConnInterface) FieldAccessClient {
+	return &fieldAccessClient{cc}
+}
+
+func (c *fieldAccessClient) GetProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.FieldAccess/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fieldAccessClient) ListProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProfileList, error) {
+	out := new(ProfileList)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.FieldAccess/ListProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fieldAccessClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.FieldAccess/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FieldAccessServer is the server API for FieldAccess service.
+// All implementations must embed UnimplementedFieldAccessServer
+// for forward compatibility
+type FieldAccessServer interface {
+	GetProfile(context.Context, *emptypb.Empty) (*Profile, error)
+	ListProfiles(context.Context, *emptypb.Empty) (*ProfileList, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFieldAccessServer()
+}
+
+// UnimplementedFieldAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedFieldAccessServer struct {
+}
+
+func (UnimplementedFieldAccessServer) GetProfile(context.Context, *emptypb.Empty) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedFieldAccessServer) ListProfiles(context.Context, *emptypb.Empty) (*ProfileList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (UnimplementedFieldAccessServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedFieldAccessServer) mustEmbedUnimplementedFieldAccessServer() {}
+
+// UnsafeFieldAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FieldAccessServer will
+// result in compilation errors.
+type UnsafeFieldAccessServer interface {
+	mustEmbedUnimplementedFieldAccessServer()
+}
+
+func RegisterFieldAccessServer(s grpc.ServiceRegistrar, srv FieldAccessServer) {
+	s.RegisterService(&FieldAccess_ServiceDesc, srv)
+}
+
+func _FieldAccess_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FieldAccessServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.FieldAccess/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FieldAccessServer).GetProfile(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FieldAccess_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FieldAccessServer).ListProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.FieldAccess/ListProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FieldAccessServer).ListProfiles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FieldAccess_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FieldAccessServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.FieldAccess/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FieldAccessServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FieldAccess_ServiceDesc is the grpc.ServiceDesc for FieldAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FieldAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.FieldAccess",
+	HandlerType: (*FieldAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfile",
+			Handler:    _FieldAccess_GetProfile_Handler,
+		},
+		{
+			MethodName: "ListProfiles",
+			Handler:    _FieldAccess_ListProfiles_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _FieldAccess_UpdateProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/field_access.proto",
+}
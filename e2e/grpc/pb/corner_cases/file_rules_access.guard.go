@@ -0,0 +1,57 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/file_rules_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_FileRulesAccess = guard.Service{
+	Name: "FileRulesAccess",
+	FileRules: []*guard.Rule{
+		{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{
+					Roles:       []string{"user"},
+					Requirement: guard.Requirement(0),
+				},
+			},
+		},
+	},
+	Methods: map[string]*guard.Method{
+		"Public": {
+			Rules: []*guard.Rule{
+				{
+					AllowPublic: guard.Ptr(true),
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedFileRulesAccessServer) GuardService() *guard.Service {
+	return &guardService_FileRulesAccess
+}
+
+var guardService_FileRulesOverrideAccess = guard.Service{
+	Name: "FileRulesOverrideAccess",
+	Rules: []*guard.Rule{
+		{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{
+					Roles:       []string{"admin"},
+					Requirement: guard.Requirement(0),
+				},
+			},
+		},
+	},
+	Methods: map[string]*guard.Method{},
+}
+
+func (UnimplementedFileRulesOverrideAccessServer) GuardService() *guard.Service {
+	return &guardService_FileRulesOverrideAccess
+}
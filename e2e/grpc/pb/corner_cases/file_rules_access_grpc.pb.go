@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/file_rules_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FileRulesAccessClient is the client API for FileRulesAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FileRulesAccessClient interface {
+	// Inherits file rules.
+	Read(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Overrides file rules.
+	Public(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type fileRulesAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFileRulesAccessClient(cc grpc.ClientConnInterface) FileRulesAccessClient {
+	return &fileRulesAccessClient{cc}
+}
+
+func (c *fileRulesAccessClient) Read(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.FileRulesAccess/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileRulesAccessClient) Public(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.FileRulesAccess/Public", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FileRulesAccessServer is the server API for FileRulesAccess service.
+// All implementations must embed UnimplementedFileRulesAccessServer
+// for forward compatibility
+type FileRulesAccessServer interface {
+	// Inherits file rules.
+	Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Overrides file rules.
+	Public(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFileRulesAccessServer()
+}
+
+// UnimplementedFileRulesAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedFileRulesAccessServer struct {
+}
+
+func (UnimplementedFileRulesAccessServer) Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedFileRulesAccessServer) Public(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Public not implemented")
+}
+func (UnimplementedFileRulesAccessServer) mustEmbedUnimplementedFileRulesAccessServer() {}
+
+// UnsafeFileRulesAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FileRulesAccessServer will
+// result in compilation errors.
+type UnsafeFileRulesAccessServer interface {
+	mustEmbedUnimplementedFileRulesAccessServer()
+}
+
+func RegisterFileRulesAccessServer(s grpc.ServiceRegistrar, srv FileRulesAccessServer) {
+	s.RegisterService(&FileRulesAccess_ServiceDesc, srv)
+}
+
+func _FileRulesAccess_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileRulesAccessServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.FileRulesAccess/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileRulesAccessServer).Read(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileRulesAccess_Public_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileRulesAccessServer).Public(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.FileRulesAccess/Public",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileRulesAccessServer).Public(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FileRulesAccess_ServiceDesc is the grpc.ServiceDesc for FileRulesAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FileRulesAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.FileRulesAccess",
+	HandlerType: (*FileRulesAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _FileRulesAccess_Read_Handler,
+		},
+		{
+			MethodName: "Public",
+			Handler:    _FileRulesAccess_Public_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/file_rules_access.proto",
+}
+
+// FileRulesOverrideAccessClient is the client API for FileRulesOverrideAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FileRulesOverrideAccessClient interface {
+	// Inherits service rules.
+	Read(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type fileRulesOverrideAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFileRulesOverrideAccessClient(cc grpc.ClientConnInterface) FileRulesOverrideAccessClient {
+	return &fileRulesOverrideAccessClient{cc}
+}
+
+func (c *fileRulesOverrideAccessClient) Read(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.FileRulesOverrideAccess/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FileRulesOverrideAccessServer is the server API for FileRulesOverrideAccess service.
+// All implementations must embed UnimplementedFileRulesOverrideAccessServer
+// for forward compatibility
+type FileRulesOverrideAccessServer interface {
+	// Inherits service rules.
+	Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFileRulesOverrideAccessServer()
+}
+
+// UnimplementedFileRulesOverrideAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedFileRulesOverrideAccessServer struct {
+}
+
+func (UnimplementedFileRulesOverrideAccessServer) Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedFileRulesOverrideAccessServer) mustEmbedUnimplementedFileRulesOverrideAccessServer() {
+}
+
+// UnsafeFileRulesOverrideAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FileRulesOverrideAccessServer will
+// result in compilation errors.
+type UnsafeFileRulesOverrideAccessServer interface {
+	mustEmbedUnimplementedFileRulesOverrideAccessServer()
+}
+
+func RegisterFileRulesOverrideAccessServer(s grpc.ServiceRegistrar, srv FileRulesOverrideAccessServer) {
+	s.RegisterService(&FileRulesOverrideAccess_ServiceDesc, srv)
+}
+
+func _FileRulesOverrideAccess_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileRulesOverrideAccessServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.FileRulesOverrideAccess/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileRulesOverrideAccessServer).Read(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FileRulesOverrideAccess_ServiceDesc is the grpc.ServiceDesc for FileRulesOverrideAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FileRulesOverrideAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.FileRulesOverrideAccess",
+	HandlerType: (*FileRulesOverrideAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _FileRulesOverrideAccess_Read_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/file_rules_access.proto",
+}
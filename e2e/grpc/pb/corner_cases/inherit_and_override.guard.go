@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-guard. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc (unknown)
+// - protoc-gen-go-guard (unknown)
 // - protoc             v6.32.0
 // source: e2e/grpc/api/corner_cases/inherit_and_override.proto
 
@@ -48,3 +48,49 @@ var guardService_InheritAndOverrideTwo = guard.Service{
 func (UnimplementedInheritAndOverrideTwoServer) GuardService() *guard.Service {
 	return &guardService_InheritAndOverrideTwo
 }
+
+var guardService_InheritAndOverrideThree = guard.Service{
+	Name: "InheritAndOverrideThree",
+	Rules: []*guard.Rule{
+		{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{
+					Roles:       []string{"user"},
+					Requirement: guard.Requirement(0),
+				},
+			},
+		},
+	},
+	Methods: map[string]*guard.Method{
+		"AppendedMethod": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"support"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+			},
+			Inherit: guard.Inherit(1),
+		},
+		"IntersectedMethod": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						ScopeBased: &guard.ScopeBased{
+							Scopes:      []string{"write"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+			},
+			Inherit: guard.Inherit(2),
+		},
+	},
+}
+
+func (UnimplementedInheritAndOverrideThreeServer) GuardService() *guard.Service {
+	return &guardService_InheritAndOverrideThree
+}
@@ -53,11 +53,25 @@ var file_e2e_grpc_api_corner_cases_inherit_and_override_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
 	0x74, 0x79, 0x22, 0x06, 0x92, 0xb5, 0x18, 0x02, 0x08, 0x00, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02,
-	0x08, 0x01, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x65,
-	0x32, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65,
-	0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x08, 0x01, 0x32, 0xdc, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x41, 0x6e,
+	0x64, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x54, 0x68, 0x72, 0x65, 0x65, 0x12, 0x57,
+	0x0a, 0x0e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x15, 0x92, 0xb5, 0x18, 0x0d, 0x1a, 0x0b, 0x0a, 0x09, 0x0a, 0x07, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0xd0, 0xb5, 0x18, 0x01, 0x12, 0x58, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x13, 0x92, 0xb5,
+	0x18, 0x0b, 0x1a, 0x09, 0x22, 0x07, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0xd0, 0xb5, 0x18,
+	0x02, 0x1a, 0x0e, 0x8a, 0xb5, 0x18, 0x0a, 0x1a, 0x08, 0x0a, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x65, 0x32,
+	0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_e2e_grpc_api_corner_cases_inherit_and_override_proto_goTypes = []interface{}{
@@ -68,12 +82,16 @@ var file_e2e_grpc_api_corner_cases_inherit_and_override_proto_depIdxs = []int32{
 	0, // 1: e2e.corner_cases.InheritAndOverrideOne.OverriddenMethod:input_type -> google.protobuf.Empty
 	0, // 2: e2e.corner_cases.InheritAndOverrideTwo.InheritedMethod:input_type -> google.protobuf.Empty
 	0, // 3: e2e.corner_cases.InheritAndOverrideTwo.OverriddenMethod:input_type -> google.protobuf.Empty
-	0, // 4: e2e.corner_cases.InheritAndOverrideOne.InheritedMethod:output_type -> google.protobuf.Empty
-	0, // 5: e2e.corner_cases.InheritAndOverrideOne.OverriddenMethod:output_type -> google.protobuf.Empty
-	0, // 6: e2e.corner_cases.InheritAndOverrideTwo.InheritedMethod:output_type -> google.protobuf.Empty
-	0, // 7: e2e.corner_cases.InheritAndOverrideTwo.OverriddenMethod:output_type -> google.protobuf.Empty
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	0, // 4: e2e.corner_cases.InheritAndOverrideThree.AppendedMethod:input_type -> google.protobuf.Empty
+	0, // 5: e2e.corner_cases.InheritAndOverrideThree.IntersectedMethod:input_type -> google.protobuf.Empty
+	0, // 6: e2e.corner_cases.InheritAndOverrideOne.InheritedMethod:output_type -> google.protobuf.Empty
+	0, // 7: e2e.corner_cases.InheritAndOverrideOne.OverriddenMethod:output_type -> google.protobuf.Empty
+	0, // 8: e2e.corner_cases.InheritAndOverrideTwo.InheritedMethod:output_type -> google.protobuf.Empty
+	0, // 9: e2e.corner_cases.InheritAndOverrideTwo.OverriddenMethod:output_type -> google.protobuf.Empty
+	0, // 10: e2e.corner_cases.InheritAndOverrideThree.AppendedMethod:output_type -> google.protobuf.Empty
+	0, // 11: e2e.corner_cases.InheritAndOverrideThree.IntersectedMethod:output_type -> google.protobuf.Empty
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -92,7 +110,7 @@ func file_e2e_grpc_api_corner_cases_inherit_and_override_proto_init() {
 			NumEnums:      0,
 			NumMessages:   0,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_e2e_grpc_api_corner_cases_inherit_and_override_proto_goTypes,
 		DependencyIndexes: file_e2e_grpc_api_corner_cases_inherit_and_override_proto_depIdxs,
@@ -270,3 +270,130 @@ var InheritAndOverrideTwo_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "e2e/grpc/api/corner_cases/inherit_and_override.proto",
 }
+
+// InheritAndOverrideThreeClient is the client API for InheritAndOverrideThree service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InheritAndOverrideThreeClient interface {
+	// Call with service rules or method rules.
+	AppendedMethod(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Call with both service rules and method rules.
+	IntersectedMethod(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type inheritAndOverrideThreeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInheritAndOverrideThreeClient(cc grpc.ClientConnInterface) InheritAndOverrideThreeClient {
+	return &inheritAndOverrideThreeClient{cc}
+}
+
+func (c *inheritAndOverrideThreeClient) AppendedMethod(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.InheritAndOverrideThree/AppendedMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inheritAndOverrideThreeClient) IntersectedMethod(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.InheritAndOverrideThree/IntersectedMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InheritAndOverrideThreeServer is the server API for InheritAndOverrideThree service.
+// All implementations must embed UnimplementedInheritAndOverrideThreeServer
+// for forward compatibility
+type InheritAndOverrideThreeServer interface {
+	// Call with service rules or method rules.
+	AppendedMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Call with both service rules and method rules.
+	IntersectedMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedInheritAndOverrideThreeServer()
+}
+
+// UnimplementedInheritAndOverrideThreeServer must be embedded to have forward compatible implementations.
+type UnimplementedInheritAndOverrideThreeServer struct {
+}
+
+func (UnimplementedInheritAndOverrideThreeServer) AppendedMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendedMethod not implemented")
+}
+func (UnimplementedInheritAndOverrideThreeServer) IntersectedMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntersectedMethod not implemented")
+}
+func (UnimplementedInheritAndOverrideThreeServer) mustEmbedUnimplementedInheritAndOverrideThreeServer() {
+}
+
+// UnsafeInheritAndOverrideThreeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InheritAndOverrideThreeServer will
+// result in compilation errors.
+type UnsafeInheritAndOverrideThreeServer interface {
+	mustEmbedUnimplementedInheritAndOverrideThreeServer()
+}
+
+func RegisterInheritAndOverrideThreeServer(s grpc.ServiceRegistrar, srv InheritAndOverrideThreeServer) {
+	s.RegisterService(&InheritAndOverrideThree_ServiceDesc, srv)
+}
+
+func _InheritAndOverrideThree_AppendedMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InheritAndOverrideThreeServer).AppendedMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.InheritAndOverrideThree/AppendedMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InheritAndOverrideThreeServer).AppendedMethod(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InheritAndOverrideThree_IntersectedMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InheritAndOverrideThreeServer).IntersectedMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.InheritAndOverrideThree/IntersectedMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InheritAndOverrideThreeServer).IntersectedMethod(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InheritAndOverrideThree_ServiceDesc is the grpc.ServiceDesc for InheritAndOverrideThree service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InheritAndOverrideThree_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.InheritAndOverrideThree",
+	HandlerType: (*InheritAndOverrideThreeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AppendedMethod",
+			Handler:    _InheritAndOverrideThree_AppendedMethod_Handler,
+		},
+		{
+			MethodName: "IntersectedMethod",
+			Handler:    _InheritAndOverrideThree_IntersectedMethod_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/inherit_and_override.proto",
+}
@@ -0,0 +1,55 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/metadata_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_MetadataAccess = guard.Service{
+	Name: "MetadataAccess",
+	Methods: map[string]*guard.Method{
+		"ClientApps": {
+			Rules: []*guard.Rule{
+				{
+					RequireAuthentication: guard.Ptr(true),
+					Metadata: []*guard.MetadataMatch{
+						{
+							Key:    "x-client-app",
+							Values: []string{"ios", "android"},
+						},
+					},
+				},
+			},
+		},
+		"Support": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"admin"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+					Metadata: []*guard.MetadataMatch{
+						{
+							Key: "x-request-reason",
+						},
+						{
+							Key:    "x-debug",
+							Absent: true,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedMetadataAccessServer) GuardService() *guard.Service {
+	return &guardService_MetadataAccess
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/metadata_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MetadataAccessClient is the client API for MetadataAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MetadataAccessClient interface {
+	// Authenticated access from mobile client applications only.
+	ClientApps(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Admin access with a stated reason, outside of debug sessions.
+	Support(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type metadataAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMetadataAccessClient(cc grpc.ClientConnInterface) MetadataAccessClient {
+	return &metadataAccessClient{cc}
+}
+
+func (c *metadataAccessClient) ClientApps(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.MetadataAccess/ClientApps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataAccessClient) Support(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.MetadataAccess/Support", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetadataAccessServer is the server API for MetadataAccess service.
+// All implementations must embed UnimplementedMetadataAccessServer
+// for forward compatibility
+type MetadataAccessServer interface {
+	// Authenticated access from mobile client applications only.
+	ClientApps(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Admin access with a stated reason, outside of debug sessions.
+	Support(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedMetadataAccessServer()
+}
+
+// UnimplementedMetadataAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedMetadataAccessServer struct {
+}
+
+func (UnimplementedMetadataAccessServer) ClientApps(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientApps not implemented")
+}
+func (UnimplementedMetadataAccessServer) Support(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Support not implemented")
+}
+func (UnimplementedMetadataAccessServer) mustEmbedUnimplementedMetadataAccessServer() {}
+
+// UnsafeMetadataAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetadataAccessServer will
+// result in compilation errors.
+type UnsafeMetadataAccessServer interface {
+	mustEmbedUnimplementedMetadataAccessServer()
+}
+
+func RegisterMetadataAccessServer(s grpc.ServiceRegistrar, srv MetadataAccessServer) {
+	s.RegisterService(&MetadataAccess_ServiceDesc, srv)
+}
+
+func _MetadataAccess_ClientApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataAccessServer).ClientApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.MetadataAccess/ClientApps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataAccessServer).ClientApps(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataAccess_Support_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataAccessServer).Support(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.MetadataAccess/Support",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataAccessServer).Support(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MetadataAccess_ServiceDesc is the grpc.ServiceDesc for MetadataAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MetadataAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.MetadataAccess",
+	HandlerType: (*MetadataAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClientApps",
+			Handler:    _MetadataAccess_ClientApps_Handler,
+		},
+		{
+			MethodName: "Support",
+			Handler:    _MetadataAccess_Support_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/metadata_access.proto",
+}
@@ -0,0 +1,52 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/network_access.proto
+
+package corner_cases
+
+import (
+	"net/netip"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_NetworkAccess = guard.Service{
+	Name: "NetworkAccess",
+	Methods: map[string]*guard.Method{
+		"Blocklist": {
+			Rules: []*guard.Rule{
+				{
+					AllowPublic: guard.Ptr(true),
+					Network: &guard.Network{
+						Deny: []netip.Prefix{
+							netip.MustParsePrefix("203.0.113.0/24"),
+						},
+					},
+				},
+			},
+		},
+		"Internal": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"admin"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+					Network: &guard.Network{
+						Allow: []netip.Prefix{
+							netip.MustParsePrefix("10.8.0.0/16"),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedNetworkAccessServer) GuardService() *guard.Service {
+	return &guardService_NetworkAccess
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/network_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// NetworkAccessClient is the client API for NetworkAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NetworkAccessClient interface {
+	// Admin access from the VPN range only.
+	Internal(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Public access except for a blocked range.
+	Blocklist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type networkAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNetworkAccessClient(cc grpc.ClientConnInterface) NetworkAccessClient {
+	return &networkAccessClient{cc}
+}
+
+func (c *networkAccessClient) Internal(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.NetworkAccess/Internal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkAccessClient) Blocklist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.NetworkAccess/Blocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NetworkAccessServer is the server API for NetworkAccess service.
+// All implementations must embed UnimplementedNetworkAccessServer
+// for forward compatibility
+type NetworkAccessServer interface {
+	// Admin access from the VPN range only.
+	Internal(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Public access except for a blocked range.
+	Blocklist(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedNetworkAccessServer()
+}
+
+// UnimplementedNetworkAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedNetworkAccessServer struct {
+}
+
+func (UnimplementedNetworkAccessServer) Internal(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Internal not implemented")
+}
+func (UnimplementedNetworkAccessServer) Blocklist(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blocklist not implemented")
+}
+func (UnimplementedNetworkAccessServer) mustEmbedUnimplementedNetworkAccessServer() {}
+
+// UnsafeNetworkAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NetworkAccessServer will
+// result in compilation errors.
+type UnsafeNetworkAccessServer interface {
+	mustEmbedUnimplementedNetworkAccessServer()
+}
+
+func RegisterNetworkAccessServer(s grpc.ServiceRegistrar, srv NetworkAccessServer) {
+	s.RegisterService(&NetworkAccess_ServiceDesc, srv)
+}
+
+func _NetworkAccess_Internal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkAccessServer).Internal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.NetworkAccess/Internal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkAccessServer).Internal(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkAccess_Blocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkAccessServer).Blocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.NetworkAccess/Blocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkAccessServer).Blocklist(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NetworkAccess_ServiceDesc is the grpc.ServiceDesc for NetworkAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NetworkAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.NetworkAccess",
+	HandlerType: (*NetworkAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Internal",
+			Handler:    _NetworkAccess_Internal_Handler,
+		},
+		{
+			MethodName: "Blocklist",
+			Handler:    _NetworkAccess_Blocklist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/network_access.proto",
+}
@@ -0,0 +1,87 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/ownership_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_OwnershipAccess = guard.Service{
+	Name: "OwnershipAccess",
+	Methods: map[string]*guard.Method{
+		"CancelOrder": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"admin"},
+							Requirement: guard.Requirement(0),
+						},
+						Combine: guard.Combine(1),
+						Ownership: &guard.Ownership{
+							RequestField: "user_id",
+							SubjectAttr:  "id",
+							RequestValue: func(request any) (any, bool) {
+								switch r := request.(type) {
+								case *GetOrderRequest:
+									return r.GetUserId(), true
+								}
+								return nil, false
+							},
+						},
+					},
+				},
+			},
+		},
+		"DeleteOrder": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"user"},
+							Requirement: guard.Requirement(0),
+						},
+						Ownership: &guard.Ownership{
+							RequestField: "owner.id",
+							SubjectAttr:  "id",
+							RequestValue: func(request any) (any, bool) {
+								switch r := request.(type) {
+								case *DeleteOrderRequest:
+									return r.GetOwner().GetId(), true
+								}
+								return nil, false
+							},
+						},
+					},
+				},
+			},
+		},
+		"GetOrder": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						Ownership: &guard.Ownership{
+							RequestField: "user_id",
+							SubjectAttr:  "id",
+							RequestValue: func(request any) (any, bool) {
+								switch r := request.(type) {
+								case *GetOrderRequest:
+									return r.GetUserId(), true
+								}
+								return nil, false
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedOwnershipAccessServer) GuardService() *guard.Service {
+	return &guardService_OwnershipAccess
+}
@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/ownership_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OwnershipAccessClient is the client API for OwnershipAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OwnershipAccessClient interface {
+	// Allow only the user the order belongs to.
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Allow only the owner with the user role.
+	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Allow the owner of the order or any admin.
+	CancelOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type ownershipAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOwnershipAccessClient(cc grpc.ClientConnInterface) OwnershipAccessClient {
+	return &ownershipAccessClient{cc}
+}
+
+func (c *ownershipAccessClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.OwnershipAccess/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ownershipAccessClient) DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.OwnershipAccess/DeleteOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ownershipAccessClient) CancelOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.OwnershipAccess/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OwnershipAccessServer is the server API for OwnershipAccess service.
+// All implementations must embed UnimplementedOwnershipAccessServer
+// for forward compatibility
+type OwnershipAccessServer interface {
+	// Allow only the user the order belongs to.
+	GetOrder(context.Context, *GetOrderRequest) (*emptypb.Empty, error)
+	// Allow only the owner with the user role.
+	DeleteOrder(context.Context, *DeleteOrderRequest) (*emptypb.Empty, error)
+	// Allow the owner of the order or any admin.
+	CancelOrder(context.Context, *GetOrderRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedOwnershipAccessServer()
+}
+
+// UnimplementedOwnershipAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedOwnershipAccessServer struct {
+}
+
+func (UnimplementedOwnershipAccessServer) GetOrder(context.Context, *GetOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedOwnershipAccessServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+}
+func (UnimplementedOwnershipAccessServer) CancelOrder(context.Context, *GetOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOwnershipAccessServer) mustEmbedUnimplementedOwnershipAccessServer() {}
+
+// UnsafeOwnershipAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OwnershipAccessServer will
+// result in compilation errors.
+type UnsafeOwnershipAccessServer interface {
+	mustEmbedUnimplementedOwnershipAccessServer()
+}
+
+func RegisterOwnershipAccessServer(s grpc.ServiceRegistrar, srv OwnershipAccessServer) {
+	s.RegisterService(&OwnershipAccess_ServiceDesc, srv)
+}
+
+func _OwnershipAccess_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OwnershipAccessServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.OwnershipAccess/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OwnershipAccessServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OwnershipAccess_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OwnershipAccessServer).DeleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.OwnershipAccess/DeleteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OwnershipAccessServer).DeleteOrder(ctx, req.(*DeleteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OwnershipAccess_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OwnershipAccessServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.OwnershipAccess/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OwnershipAccessServer).CancelOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OwnershipAccess_ServiceDesc is the grpc.ServiceDesc for OwnershipAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OwnershipAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.OwnershipAccess",
+	HandlerType: (*OwnershipAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOrder",
+			Handler:    _OwnershipAccess_GetOrder_Handler,
+		},
+		{
+			MethodName: "DeleteOrder",
+			Handler:    _OwnershipAccess_DeleteOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OwnershipAccess_CancelOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/ownership_access.proto",
+}
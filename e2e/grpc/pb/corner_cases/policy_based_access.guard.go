@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-guard. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc (unknown)
+// - protoc-gen-go-guard (unknown)
 // - protoc             v6.32.0
 // source: e2e/grpc/api/corner_cases/policy_based_access.proto
 
@@ -61,6 +61,40 @@ var guardService_PolicyBasedAccess = guard.Service{
 				},
 			},
 		},
+		"PolicyWithHighLimitArgument": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						PolicyBased: &guard.PolicyBased{
+							Policies:    []string{"quota"},
+							Requirement: guard.Requirement(0),
+							Args: map[string]guard.PolicyArgs{
+								"quota": {
+									"limit": float64(100),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"PolicyWithLowLimitArgument": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						PolicyBased: &guard.PolicyBased{
+							Policies:    []string{"quota"},
+							Requirement: guard.Requirement(0),
+							Args: map[string]guard.PolicyArgs{
+								"quota": {
+									"limit": float64(10),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	},
 }
 
@@ -31,7 +31,7 @@ var file_e2e_grpc_api_corner_cases_policy_based_access_proto_rawDesc = []byte{
 	0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xe3, 0x03, 0x0a, 0x11, 0x50, 0x6f, 0x6c, 0x69,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xce, 0x05, 0x0a, 0x11, 0x50, 0x6f, 0x6c, 0x69,
 	0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5d, 0x0a,
 	0x1f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x57, 0x69,
 	0x74, 0x68, 0x41, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
@@ -59,14 +59,29 @@ var file_e2e_grpc_api_corner_cases_policy_based_access_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
 	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x30, 0x92, 0xb5, 0x18,
-	0x2c, 0x1a, 0x2a, 0x12, 0x28, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2d,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2d, 0x31, 0x0a, 0x11, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2d, 0x31, 0x10, 0x01, 0x42, 0x43, 0x5a,
-	0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73, 0x6e,
-	0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
-	0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x65, 0x32, 0x65, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73,
-	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2c, 0x1a, 0x2a, 0x12, 0x28, 0x10, 0x01, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2d, 0x31, 0x0a, 0x11, 0x6e, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2d, 0x31, 0x12, 0x73, 0x0a,
+	0x1a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x6f, 0x77, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x92, 0xb5, 0x18,
+	0x21, 0x1a, 0x1f, 0x12, 0x1d, 0x1a, 0x1b, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x24, 0x40, 0x12, 0x74, 0x0a, 0x1b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x57, 0x69, 0x74, 0x68,
+	0x48, 0x69, 0x67, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x25, 0x92, 0xb5, 0x18, 0x21, 0x1a, 0x1f, 0x12, 0x1d, 0x1a, 0x1b, 0x12, 0x12, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59,
+	0x40, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2f, 0x65, 0x32, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62,
+	0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_e2e_grpc_api_corner_cases_policy_based_access_proto_goTypes = []interface{}{
@@ -77,12 +92,16 @@ var file_e2e_grpc_api_corner_cases_policy_based_access_proto_depIdxs = []int32{
 	0, // 1: e2e.corner_cases.PolicyBasedAccess.EmptyPoliciesWithAllRequirement:input_type -> google.protobuf.Empty
 	0, // 2: e2e.corner_cases.PolicyBasedAccess.MultiplePoliciesWithAnyRequirement:input_type -> google.protobuf.Empty
 	0, // 3: e2e.corner_cases.PolicyBasedAccess.MultiplePoliciesWithAllRequirement:input_type -> google.protobuf.Empty
-	0, // 4: e2e.corner_cases.PolicyBasedAccess.EmptyPoliciesWithAnyRequirement:output_type -> google.protobuf.Empty
-	0, // 5: e2e.corner_cases.PolicyBasedAccess.EmptyPoliciesWithAllRequirement:output_type -> google.protobuf.Empty
-	0, // 6: e2e.corner_cases.PolicyBasedAccess.MultiplePoliciesWithAnyRequirement:output_type -> google.protobuf.Empty
-	0, // 7: e2e.corner_cases.PolicyBasedAccess.MultiplePoliciesWithAllRequirement:output_type -> google.protobuf.Empty
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	0, // 4: e2e.corner_cases.PolicyBasedAccess.PolicyWithLowLimitArgument:input_type -> google.protobuf.Empty
+	0, // 5: e2e.corner_cases.PolicyBasedAccess.PolicyWithHighLimitArgument:input_type -> google.protobuf.Empty
+	0, // 6: e2e.corner_cases.PolicyBasedAccess.EmptyPoliciesWithAnyRequirement:output_type -> google.protobuf.Empty
+	0, // 7: e2e.corner_cases.PolicyBasedAccess.EmptyPoliciesWithAllRequirement:output_type -> google.protobuf.Empty
+	0, // 8: e2e.corner_cases.PolicyBasedAccess.MultiplePoliciesWithAnyRequirement:output_type -> google.protobuf.Empty
+	0, // 9: e2e.corner_cases.PolicyBasedAccess.MultiplePoliciesWithAllRequirement:output_type -> google.protobuf.Empty
+	0, // 10: e2e.corner_cases.PolicyBasedAccess.PolicyWithLowLimitArgument:output_type -> google.protobuf.Empty
+	0, // 11: e2e.corner_cases.PolicyBasedAccess.PolicyWithHighLimitArgument:output_type -> google.protobuf.Empty
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
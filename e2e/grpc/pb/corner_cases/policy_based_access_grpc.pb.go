@@ -27,6 +27,8 @@ type PolicyBasedAccessClient interface {
 	EmptyPoliciesWithAllRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	MultiplePoliciesWithAnyRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	MultiplePoliciesWithAllRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PolicyWithLowLimitArgument(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PolicyWithHighLimitArgument(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type policyBasedAccessClient struct {
@@ -73,6 +75,24 @@ func (c *policyBasedAccessClient) MultiplePoliciesWithAllRequirement(ctx context
 	return out, nil
 }
 
+func (c *policyBasedAccessClient) PolicyWithLowLimitArgument(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.PolicyBasedAccess/PolicyWithLowLimitArgument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyBasedAccessClient) PolicyWithHighLimitArgument(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.PolicyBasedAccess/PolicyWithHighLimitArgument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PolicyBasedAccessServer is the server API for PolicyBasedAccess service.
 // All implementations must embed UnimplementedPolicyBasedAccessServer
 // for forward compatibility
@@ -81,6 +101,8 @@ type PolicyBasedAccessServer interface {
 	EmptyPoliciesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	MultiplePoliciesWithAnyRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	MultiplePoliciesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	PolicyWithLowLimitArgument(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	PolicyWithHighLimitArgument(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedPolicyBasedAccessServer()
 }
 
@@ -100,6 +122,12 @@ func (UnimplementedPolicyBasedAccessServer) MultiplePoliciesWithAnyRequirement(c
 func (UnimplementedPolicyBasedAccessServer) MultiplePoliciesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiplePoliciesWithAllRequirement not implemented")
 }
+func (UnimplementedPolicyBasedAccessServer) PolicyWithLowLimitArgument(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyWithLowLimitArgument not implemented")
+}
+func (UnimplementedPolicyBasedAccessServer) PolicyWithHighLimitArgument(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyWithHighLimitArgument not implemented")
+}
 func (UnimplementedPolicyBasedAccessServer) mustEmbedUnimplementedPolicyBasedAccessServer() {}
 
 // UnsafePolicyBasedAccessServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +213,42 @@ func _PolicyBasedAccess_MultiplePoliciesWithAllRequirement_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PolicyBasedAccess_PolicyWithLowLimitArgument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyBasedAccessServer).PolicyWithLowLimitArgument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.PolicyBasedAccess/PolicyWithLowLimitArgument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyBasedAccessServer).PolicyWithLowLimitArgument(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyBasedAccess_PolicyWithHighLimitArgument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyBasedAccessServer).PolicyWithHighLimitArgument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.PolicyBasedAccess/PolicyWithHighLimitArgument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyBasedAccessServer).PolicyWithHighLimitArgument(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PolicyBasedAccess_ServiceDesc is the grpc.ServiceDesc for PolicyBasedAccess service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,6 +272,14 @@ var PolicyBasedAccess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiplePoliciesWithAllRequirement",
 			Handler:    _PolicyBasedAccess_MultiplePoliciesWithAllRequirement_Handler,
 		},
+		{
+			MethodName: "PolicyWithLowLimitArgument",
+			Handler:    _PolicyBasedAccess_PolicyWithLowLimitArgument_Handler,
+		},
+		{
+			MethodName: "PolicyWithHighLimitArgument",
+			Handler:    _PolicyBasedAccess_PolicyWithHighLimitArgument_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "e2e/grpc/api/corner_cases/policy_based_access.proto",
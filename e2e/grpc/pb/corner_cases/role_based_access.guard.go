@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-guard. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc (unknown)
+// - protoc-gen-go-guard (unknown)
 // - protoc             v6.32.0
 // source: e2e/grpc/api/corner_cases/role_based_access.proto
 
@@ -61,6 +61,31 @@ var guardService_RoleBasedAccess = guard.Service{
 				},
 			},
 		},
+		"MultipleRolesWithAtLeastRequirement": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"reviewer", "approver", "auditor"},
+							Requirement: guard.Requirement(3),
+							Threshold:   2,
+						},
+					},
+				},
+			},
+		},
+		"MultipleRolesWithNoneRequirement": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"suspended", "banned"},
+							Requirement: guard.Requirement(2),
+						},
+					},
+				},
+			},
+		},
 	},
 }
 
@@ -31,7 +31,7 @@ var file_e2e_grpc_api_corner_cases_role_based_access_proto_rawDesc = []byte{
 	0x63, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa7, 0x03, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x61,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x9d, 0x05, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x61,
 	0x73, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5a, 0x0a, 0x1c, 0x45, 0x6d, 0x70,
 	0x74, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x79, 0x52, 0x65,
 	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
@@ -57,12 +57,28 @@ var file_e2e_grpc_api_corner_cases_role_based_access_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
 	0x70, 0x74, 0x79, 0x22, 0x1a, 0x92, 0xb5, 0x18, 0x16, 0x1a, 0x14, 0x0a, 0x12, 0x0a, 0x05, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x10, 0x01, 0x42,
-	0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61,
-	0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
-	0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x65, 0x32, 0x65, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63,
-	0x61, 0x73, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x6d, 0x69, 0x6e, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x10, 0x01, 0x12,
+	0x71, 0x0a, 0x20, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x1d, 0x92, 0xb5, 0x18, 0x19, 0x1a, 0x17, 0x0a, 0x15, 0x0a, 0x09, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x10, 0x02, 0x12, 0x80, 0x01, 0x0a, 0x23, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29, 0x92, 0xb5, 0x18, 0x25,
+	0x1a, 0x23, 0x0a, 0x21, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x0a, 0x08,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x0a, 0x07, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x10, 0x03, 0x18, 0x02, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2f, 0x65, 0x32, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f,
+	0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var file_e2e_grpc_api_corner_cases_role_based_access_proto_goTypes = []interface{}{
@@ -73,12 +89,16 @@ var file_e2e_grpc_api_corner_cases_role_based_access_proto_depIdxs = []int32{
 	0, // 1: e2e.corner_cases.RoleBasedAccess.EmptyRolesWithAllRequirement:input_type -> google.protobuf.Empty
 	0, // 2: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithAnyRequirement:input_type -> google.protobuf.Empty
 	0, // 3: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithAllRequirement:input_type -> google.protobuf.Empty
-	0, // 4: e2e.corner_cases.RoleBasedAccess.EmptyRolesWithAnyRequirement:output_type -> google.protobuf.Empty
-	0, // 5: e2e.corner_cases.RoleBasedAccess.EmptyRolesWithAllRequirement:output_type -> google.protobuf.Empty
-	0, // 6: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithAnyRequirement:output_type -> google.protobuf.Empty
-	0, // 7: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithAllRequirement:output_type -> google.protobuf.Empty
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	0, // 4: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithNoneRequirement:input_type -> google.protobuf.Empty
+	0, // 5: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithAtLeastRequirement:input_type -> google.protobuf.Empty
+	0, // 6: e2e.corner_cases.RoleBasedAccess.EmptyRolesWithAnyRequirement:output_type -> google.protobuf.Empty
+	0, // 7: e2e.corner_cases.RoleBasedAccess.EmptyRolesWithAllRequirement:output_type -> google.protobuf.Empty
+	0, // 8: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithAnyRequirement:output_type -> google.protobuf.Empty
+	0, // 9: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithAllRequirement:output_type -> google.protobuf.Empty
+	0, // 10: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithNoneRequirement:output_type -> google.protobuf.Empty
+	0, // 11: e2e.corner_cases.RoleBasedAccess.MultipleRolesWithAtLeastRequirement:output_type -> google.protobuf.Empty
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
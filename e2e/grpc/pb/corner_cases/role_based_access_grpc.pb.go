@@ -27,6 +27,8 @@ type RoleBasedAccessClient interface {
 	EmptyRolesWithAllRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	MultipleRolesWithAnyRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	MultipleRolesWithAllRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MultipleRolesWithNoneRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MultipleRolesWithAtLeastRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type roleBasedAccessClient struct {
@@ -73,6 +75,24 @@ func (c *roleBasedAccessClient) MultipleRolesWithAllRequirement(ctx context.Cont
 	return out, nil
 }
 
+func (c *roleBasedAccessClient) MultipleRolesWithNoneRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.RoleBasedAccess/MultipleRolesWithNoneRequirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleBasedAccessClient) MultipleRolesWithAtLeastRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.RoleBasedAccess/MultipleRolesWithAtLeastRequirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleBasedAccessServer is the server API for RoleBasedAccess service.
 // All implementations must embed UnimplementedRoleBasedAccessServer
 // for forward compatibility
@@ -81,6 +101,8 @@ type RoleBasedAccessServer interface {
 	EmptyRolesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	MultipleRolesWithAnyRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	MultipleRolesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	MultipleRolesWithNoneRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	MultipleRolesWithAtLeastRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedRoleBasedAccessServer()
 }
 
@@ -100,6 +122,12 @@ func (UnimplementedRoleBasedAccessServer) MultipleRolesWithAnyRequirement(contex
 func (UnimplementedRoleBasedAccessServer) MultipleRolesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultipleRolesWithAllRequirement not implemented")
 }
+func (UnimplementedRoleBasedAccessServer) MultipleRolesWithNoneRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultipleRolesWithNoneRequirement not implemented")
+}
+func (UnimplementedRoleBasedAccessServer) MultipleRolesWithAtLeastRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultipleRolesWithAtLeastRequirement not implemented")
+}
 func (UnimplementedRoleBasedAccessServer) mustEmbedUnimplementedRoleBasedAccessServer() {}
 
 // UnsafeRoleBasedAccessServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +213,42 @@ func _RoleBasedAccess_MultipleRolesWithAllRequirement_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleBasedAccess_MultipleRolesWithNoneRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleBasedAccessServer).MultipleRolesWithNoneRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.RoleBasedAccess/MultipleRolesWithNoneRequirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleBasedAccessServer).MultipleRolesWithNoneRequirement(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleBasedAccess_MultipleRolesWithAtLeastRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleBasedAccessServer).MultipleRolesWithAtLeastRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.RoleBasedAccess/MultipleRolesWithAtLeastRequirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleBasedAccessServer).MultipleRolesWithAtLeastRequirement(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleBasedAccess_ServiceDesc is the grpc.ServiceDesc for RoleBasedAccess service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,6 +272,14 @@ var RoleBasedAccess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultipleRolesWithAllRequirement",
 			Handler:    _RoleBasedAccess_MultipleRolesWithAllRequirement_Handler,
 		},
+		{
+			MethodName: "MultipleRolesWithNoneRequirement",
+			Handler:    _RoleBasedAccess_MultipleRolesWithNoneRequirement_Handler,
+		},
+		{
+			MethodName: "MultipleRolesWithAtLeastRequirement",
+			Handler:    _RoleBasedAccess_MultipleRolesWithAtLeastRequirement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "e2e/grpc/api/corner_cases/role_based_access.proto",
@@ -0,0 +1,49 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/role_hierarchy_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_RoleHierarchyAccess = guard.Service{
+	Name: "RoleHierarchyAccess",
+	RoleHierarchy: guard.RoleHierarchy{
+		"admin":  {"editor"},
+		"editor": {"viewer"},
+	},
+	Methods: map[string]*guard.Method{
+		"Edit": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"editor"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+			},
+		},
+		"View": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"viewer"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedRoleHierarchyAccessServer) GuardService() *guard.Service {
+	return &guardService_RoleHierarchyAccess
+}
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/role_hierarchy_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RoleHierarchyAccessClient is the client API for RoleHierarchyAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoleHierarchyAccessClient interface {
+	View(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Edit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type roleHierarchyAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoleHierarchyAccessClient(cc grpc.ClientConnInterface) RoleHierarchyAccessClient {
+	return &roleHierarchyAccessClient{cc}
+}
+
+func (c *roleHierarchyAccessClient) View(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.RoleHierarchyAccess/View", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleHierarchyAccessClient) Edit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.RoleHierarchyAccess/Edit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoleHierarchyAccessServer is the server API for RoleHierarchyAccess service.
+// All implementations must embed UnimplementedRoleHierarchyAccessServer
+// for forward compatibility
+type RoleHierarchyAccessServer interface {
+	View(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Edit(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedRoleHierarchyAccessServer()
+}
+
+// UnimplementedRoleHierarchyAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedRoleHierarchyAccessServer struct {
+}
+
+func (UnimplementedRoleHierarchyAccessServer) View(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method View not implemented")
+}
+func (UnimplementedRoleHierarchyAccessServer) Edit(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Edit not implemented")
+}
+func (UnimplementedRoleHierarchyAccessServer) mustEmbedUnimplementedRoleHierarchyAccessServer() {}
+
+// UnsafeRoleHierarchyAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoleHierarchyAccessServer will
+// result in compilation errors.
+type UnsafeRoleHierarchyAccessServer interface {
+	mustEmbedUnimplementedRoleHierarchyAccessServer()
+}
+
+func RegisterRoleHierarchyAccessServer(s grpc.ServiceRegistrar, srv RoleHierarchyAccessServer) {
+	s.RegisterService(&RoleHierarchyAccess_ServiceDesc, srv)
+}
+
+func _RoleHierarchyAccess_View_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleHierarchyAccessServer).View(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.RoleHierarchyAccess/View",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleHierarchyAccessServer).View(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleHierarchyAccess_Edit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleHierarchyAccessServer).Edit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.RoleHierarchyAccess/Edit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleHierarchyAccessServer).Edit(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoleHierarchyAccess_ServiceDesc is the grpc.ServiceDesc for RoleHierarchyAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoleHierarchyAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.RoleHierarchyAccess",
+	HandlerType: (*RoleHierarchyAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "View",
+			Handler:    _RoleHierarchyAccess_View_Handler,
+		},
+		{
+			MethodName: "Edit",
+			Handler:    _RoleHierarchyAccess_Edit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/role_hierarchy_access.proto",
+}
@@ -0,0 +1,75 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/rule_set_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_RuleSetAccess = guard.Service{
+	Name: "RuleSetAccess",
+	Rules: []*guard.Rule{
+		{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{
+					Roles:       []string{"admin", "support"},
+					Requirement: guard.Requirement(0),
+				},
+			},
+		},
+	},
+	Methods: map[string]*guard.Method{
+		"Audit": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"admin", "support"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"auditor"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+			},
+		},
+		"Manage": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"admin", "support"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						ScopeBased: &guard.ScopeBased{
+							Scopes:      []string{"backoffice"},
+							Requirement: guard.Requirement(0),
+						},
+						PolicyBased: &guard.PolicyBased{
+							Policies:    []string{"positive-policy-1"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedRuleSetAccessServer) GuardService() *guard.Service {
+	return &guardService_RuleSetAccess
+}
@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/rule_set_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RuleSetAccessClient is the client API for RuleSetAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RuleSetAccessClient interface {
+	// Inherits the "staff" rule set.
+	Read(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Uses the "backoffice" rule set, which includes the "staff" rule set.
+	Manage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Uses the "staff" rule set together with an inline rule.
+	Audit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type ruleSetAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRuleSetAccessClient(cc grpc.ClientConnInterface) RuleSetAccessClient {
+	return &ruleSetAccessClient{cc}
+}
+
+func (c *ruleSetAccessClient) Read(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.RuleSetAccess/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleSetAccessClient) Manage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.RuleSetAccess/Manage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleSetAccessClient) Audit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.RuleSetAccess/Audit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RuleSetAccessServer is the server API for RuleSetAccess service.
+// All implementations must embed UnimplementedRuleSetAccessServer
+// for forward compatibility
+type RuleSetAccessServer interface {
+	// Inherits the "staff" rule set.
+	Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Uses the "backoffice" rule set, which includes the "staff" rule set.
+	Manage(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Uses the "staff" rule set together with an inline rule.
+	Audit(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedRuleSetAccessServer()
+}
+
+// UnimplementedRuleSetAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedRuleSetAccessServer struct {
+}
+
+func (UnimplementedRuleSetAccessServer) Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedRuleSetAccessServer) Manage(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Manage not implemented")
+}
+func (UnimplementedRuleSetAccessServer) Audit(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Audit not implemented")
+}
+func (UnimplementedRuleSetAccessServer) mustEmbedUnimplementedRuleSetAccessServer() {}
+
+// UnsafeRuleSetAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RuleSetAccessServer will
+// result in compilation errors.
+type UnsafeRuleSetAccessServer interface {
+	mustEmbedUnimplementedRuleSetAccessServer()
+}
+
+func RegisterRuleSetAccessServer(s grpc.ServiceRegistrar, srv RuleSetAccessServer) {
+	s.RegisterService(&RuleSetAccess_ServiceDesc, srv)
+}
+
+func _RuleSetAccess_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleSetAccessServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.RuleSetAccess/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleSetAccessServer).Read(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleSetAccess_Manage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleSetAccessServer).Manage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.RuleSetAccess/Manage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleSetAccessServer).Manage(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleSetAccess_Audit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleSetAccessServer).Audit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.RuleSetAccess/Audit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleSetAccessServer).Audit(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RuleSetAccess_ServiceDesc is the grpc.ServiceDesc for RuleSetAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RuleSetAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.RuleSetAccess",
+	HandlerType: (*RuleSetAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _RuleSetAccess_Read_Handler,
+		},
+		{
+			MethodName: "Manage",
+			Handler:    _RuleSetAccess_Manage_Handler,
+		},
+		{
+			MethodName: "Audit",
+			Handler:    _RuleSetAccess_Audit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/rule_set_access.proto",
+}
@@ -0,0 +1,75 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.32.0
+// source: e2e/grpc/api/corner_cases/rule_sets.proto
+
+package corner_cases
+
+import (
+	_ "github.com/casnerano/protoc-gen-go-guard/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_e2e_grpc_api_corner_cases_rule_sets_proto protoreflect.FileDescriptor
+
+var file_e2e_grpc_api_corner_cases_rule_sets_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x65, 0x32, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x72, 0x75, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x65, 0x32, 0x65,
+	0x2e, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x11, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x42, 0xa4, 0x01, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x65, 0x32,
+	0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0xca, 0xb5, 0x18, 0x1d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x12, 0x14, 0x1a, 0x12, 0x0a, 0x10, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x0a, 0x07,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0xca, 0xb5, 0x18, 0x3c, 0x12, 0x07, 0x2a, 0x05, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x12, 0x25, 0x1a, 0x23, 0x12, 0x13, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2d, 0x31, 0x22, 0x0c, 0x0a,
+	0x0a, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x0a, 0x0a, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_e2e_grpc_api_corner_cases_rule_sets_proto_goTypes = []interface{}{}
+var file_e2e_grpc_api_corner_cases_rule_sets_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_e2e_grpc_api_corner_cases_rule_sets_proto_init() }
+func file_e2e_grpc_api_corner_cases_rule_sets_proto_init() {
+	if File_e2e_grpc_api_corner_cases_rule_sets_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_e2e_grpc_api_corner_cases_rule_sets_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_e2e_grpc_api_corner_cases_rule_sets_proto_goTypes,
+		DependencyIndexes: file_e2e_grpc_api_corner_cases_rule_sets_proto_depIdxs,
+	}.Build()
+	File_e2e_grpc_api_corner_cases_rule_sets_proto = out.File
+	file_e2e_grpc_api_corner_cases_rule_sets_proto_rawDesc = nil
+	file_e2e_grpc_api_corner_cases_rule_sets_proto_goTypes = nil
+	file_e2e_grpc_api_corner_cases_rule_sets_proto_depIdxs = nil
+}
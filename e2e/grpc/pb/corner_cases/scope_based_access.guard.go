@@ -0,0 +1,49 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/scope_based_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_ScopeBasedAccess = guard.Service{
+	Name: "ScopeBasedAccess",
+	Methods: map[string]*guard.Method{
+		"MultipleScopesWithAllRequirement": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						ScopeBased: &guard.ScopeBased{
+							Scopes:      []string{"orders:read", "orders:write"},
+							Requirement: guard.Requirement(1),
+						},
+					},
+				},
+			},
+		},
+		"RoleAndScope": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"manager"},
+							Requirement: guard.Requirement(0),
+						},
+						ScopeBased: &guard.ScopeBased{
+							Scopes:      []string{"orders:read"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedScopeBasedAccessServer) GuardService() *guard.Service {
+	return &guardService_ScopeBasedAccess
+}
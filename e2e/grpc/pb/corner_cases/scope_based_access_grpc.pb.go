@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/scope_based_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ScopeBasedAccessClient is the client API for ScopeBasedAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScopeBasedAccessClient interface {
+	MultipleScopesWithAllRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RoleAndScope(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type scopeBasedAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScopeBasedAccessClient(cc grpc.ClientConnInterface) ScopeBasedAccessClient {
+	return &scopeBasedAccessClient{cc}
+}
+
+func (c *scopeBasedAccessClient) MultipleScopesWithAllRequirement(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.ScopeBasedAccess/MultipleScopesWithAllRequirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeBasedAccessClient) RoleAndScope(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.ScopeBasedAccess/RoleAndScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScopeBasedAccessServer is the server API for ScopeBasedAccess service.
+// All implementations must embed UnimplementedScopeBasedAccessServer
+// for forward compatibility
+type ScopeBasedAccessServer interface {
+	MultipleScopesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	RoleAndScope(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedScopeBasedAccessServer()
+}
+
+// UnimplementedScopeBasedAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedScopeBasedAccessServer struct {
+}
+
+func (UnimplementedScopeBasedAccessServer) MultipleScopesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultipleScopesWithAllRequirement not implemented")
+}
+func (UnimplementedScopeBasedAccessServer) RoleAndScope(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleAndScope not implemented")
+}
+func (UnimplementedScopeBasedAccessServer) mustEmbedUnimplementedScopeBasedAccessServer() {}
+
+// UnsafeScopeBasedAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScopeBasedAccessServer will
+// result in compilation errors.
+type UnsafeScopeBasedAccessServer interface {
+	mustEmbedUnimplementedScopeBasedAccessServer()
+}
+
+func RegisterScopeBasedAccessServer(s grpc.ServiceRegistrar, srv ScopeBasedAccessServer) {
+	s.RegisterService(&ScopeBasedAccess_ServiceDesc, srv)
+}
+
+func _ScopeBasedAccess_MultipleScopesWithAllRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeBasedAccessServer).MultipleScopesWithAllRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.ScopeBasedAccess/MultipleScopesWithAllRequirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeBasedAccessServer).MultipleScopesWithAllRequirement(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeBasedAccess_RoleAndScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeBasedAccessServer).RoleAndScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.ScopeBasedAccess/RoleAndScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeBasedAccessServer).RoleAndScope(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScopeBasedAccess_ServiceDesc is the grpc.ServiceDesc for ScopeBasedAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScopeBasedAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.ScopeBasedAccess",
+	HandlerType: (*ScopeBasedAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MultipleScopesWithAllRequirement",
+			Handler:    _ScopeBasedAccess_MultipleScopesWithAllRequirement_Handler,
+		},
+		{
+			MethodName: "RoleAndScope",
+			Handler:    _ScopeBasedAccess_RoleAndScope_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/scope_based_access.proto",
+}
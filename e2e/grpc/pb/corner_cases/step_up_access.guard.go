@@ -0,0 +1,46 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/step_up_access.proto
+
+package corner_cases
+
+import (
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_StepUpAccess = guard.Service{
+	Name: "StepUpAccess",
+	Methods: map[string]*guard.Method{
+		"DeleteProfile": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						MinAuthLevel: 2,
+						MaxAuthAge:   300 * time.Second,
+					},
+				},
+			},
+		},
+		"RotateKeys": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"admin"},
+							Requirement: guard.Requirement(0),
+						},
+						MinAuthLevel: 2,
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedStepUpAccessServer) GuardService() *guard.Service {
+	return &guardService_StepUpAccess
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/step_up_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StepUpAccessClient is the client API for StepUpAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StepUpAccessClient interface {
+	// Access after MFA within the last 5 minutes.
+	DeleteProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Admin access after MFA.
+	RotateKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type stepUpAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStepUpAccessClient(cc grpc.ClientConnInterface) StepUpAccessClient {
+	return &stepUpAccessClient{cc}
+}
+
+func (c *stepUpAccessClient) DeleteProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.StepUpAccess/DeleteProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepUpAccessClient) RotateKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.StepUpAccess/RotateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StepUpAccessServer is the server API for StepUpAccess service.
+// All implementations must embed UnimplementedStepUpAccessServer
+// for forward compatibility
+type StepUpAccessServer interface {
+	// Access after MFA within the last 5 minutes.
+	DeleteProfile(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Admin access after MFA.
+	RotateKeys(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedStepUpAccessServer()
+}
+
+// UnimplementedStepUpAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedStepUpAccessServer struct {
+}
+
+func (UnimplementedStepUpAccessServer) DeleteProfile(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (UnimplementedStepUpAccessServer) RotateKeys(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKeys not implemented")
+}
+func (UnimplementedStepUpAccessServer) mustEmbedUnimplementedStepUpAccessServer() {}
+
+// UnsafeStepUpAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StepUpAccessServer will
+// result in compilation errors.
+type UnsafeStepUpAccessServer interface {
+	mustEmbedUnimplementedStepUpAccessServer()
+}
+
+func RegisterStepUpAccessServer(s grpc.ServiceRegistrar, srv StepUpAccessServer) {
+	s.RegisterService(&StepUpAccess_ServiceDesc, srv)
+}
+
+func _StepUpAccess_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepUpAccessServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.StepUpAccess/DeleteProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepUpAccessServer).DeleteProfile(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepUpAccess_RotateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepUpAccessServer).RotateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.StepUpAccess/RotateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepUpAccessServer).RotateKeys(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StepUpAccess_ServiceDesc is the grpc.ServiceDesc for StepUpAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StepUpAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.StepUpAccess",
+	HandlerType: (*StepUpAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _StepUpAccess_DeleteProfile_Handler,
+		},
+		{
+			MethodName: "RotateKeys",
+			Handler:    _StepUpAccess_RotateKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/step_up_access.proto",
+}
@@ -0,0 +1,73 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/time_window_access.proto
+
+package corner_cases
+
+import (
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_TimeWindowAccess = guard.Service{
+	Name: "TimeWindowAccess",
+	Methods: map[string]*guard.Method{
+		"BusinessHours": {
+			Rules: []*guard.Rule{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{
+							Roles:       []string{"backoffice"},
+							Requirement: guard.Requirement(0),
+						},
+					},
+					Windows: []*guard.TimeWindow{
+						{
+							Weekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+							Start:    540 * time.Minute,
+							End:      1080 * time.Minute,
+							TimeZone: "Europe/Berlin",
+						},
+					},
+				},
+			},
+		},
+		"Expired": {
+			Rules: []*guard.Rule{
+				{
+					AllowPublic: guard.Ptr(true),
+					ValidUntil:  guard.Ptr(time.Unix(946684800, 0)),
+				},
+			},
+		},
+		"Migration": {
+			Rules: []*guard.Rule{
+				{
+					RequireAuthentication: guard.Ptr(true),
+					ValidFrom:             guard.Ptr(time.Unix(946684800, 0)),
+					ValidUntil:            guard.Ptr(time.Unix(4102444800, 0)),
+				},
+			},
+		},
+		"Weekend": {
+			Rules: []*guard.Rule{
+				{
+					RequireAuthentication: guard.Ptr(true),
+					Windows: []*guard.TimeWindow{
+						{
+							Weekdays: []time.Weekday{time.Saturday, time.Sunday},
+							End:      1440 * time.Minute,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedTimeWindowAccessServer) GuardService() *guard.Service {
+	return &guardService_TimeWindowAccess
+}
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/time_window_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TimeWindowAccessClient is the client API for TimeWindowAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TimeWindowAccessClient interface {
+	// Public access that has already expired.
+	Expired(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Temporary access for a migration period.
+	Migration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Access during business hours only.
+	BusinessHours(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Access on weekends only.
+	Weekend(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type timeWindowAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimeWindowAccessClient(cc grpc.ClientConnInterface) TimeWindowAccessClient {
+	return &timeWindowAccessClient{cc}
+}
+
+func (c *timeWindowAccessClient) Expired(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.TimeWindowAccess/Expired", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeWindowAccessClient) Migration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.TimeWindowAccess/Migration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeWindowAccessClient) BusinessHours(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.TimeWindowAccess/BusinessHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeWindowAccessClient) Weekend(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.TimeWindowAccess/Weekend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimeWindowAccessServer is the server API for TimeWindowAccess service.
+// All implementations must embed UnimplementedTimeWindowAccessServer
+// for forward compatibility
+type TimeWindowAccessServer interface {
+	// Public access that has already expired.
+	Expired(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Temporary access for a migration period.
+	Migration(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Access during business hours only.
+	BusinessHours(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Access on weekends only.
+	Weekend(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTimeWindowAccessServer()
+}
+
+// UnimplementedTimeWindowAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedTimeWindowAccessServer struct {
+}
+
+func (UnimplementedTimeWindowAccessServer) Expired(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expired not implemented")
+}
+func (UnimplementedTimeWindowAccessServer) Migration(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migration not implemented")
+}
+func (UnimplementedTimeWindowAccessServer) BusinessHours(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BusinessHours not implemented")
+}
+func (UnimplementedTimeWindowAccessServer) Weekend(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Weekend not implemented")
+}
+func (UnimplementedTimeWindowAccessServer) mustEmbedUnimplementedTimeWindowAccessServer() {}
+
+// UnsafeTimeWindowAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimeWindowAccessServer will
+// result in compilation errors.
+type UnsafeTimeWindowAccessServer interface {
+	mustEmbedUnimplementedTimeWindowAccessServer()
+}
+
+func RegisterTimeWindowAccessServer(s grpc.ServiceRegistrar, srv TimeWindowAccessServer) {
+	s.RegisterService(&TimeWindowAccess_ServiceDesc, srv)
+}
+
+func _TimeWindowAccess_Expired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeWindowAccessServer).Expired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.TimeWindowAccess/Expired",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeWindowAccessServer).Expired(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeWindowAccess_Migration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeWindowAccessServer).Migration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.TimeWindowAccess/Migration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeWindowAccessServer).Migration(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeWindowAccess_BusinessHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeWindowAccessServer).BusinessHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.TimeWindowAccess/BusinessHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeWindowAccessServer).BusinessHours(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeWindowAccess_Weekend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeWindowAccessServer).Weekend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.TimeWindowAccess/Weekend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeWindowAccessServer).Weekend(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimeWindowAccess_ServiceDesc is the grpc.ServiceDesc for TimeWindowAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimeWindowAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.TimeWindowAccess",
+	HandlerType: (*TimeWindowAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Expired",
+			Handler:    _TimeWindowAccess_Expired_Handler,
+		},
+		{
+			MethodName: "Migration",
+			Handler:    _TimeWindowAccess_Migration_Handler,
+		},
+		{
+			MethodName: "BusinessHours",
+			Handler:    _TimeWindowAccess_BusinessHours_Handler,
+		},
+		{
+			MethodName: "Weekend",
+			Handler:    _TimeWindowAccess_Weekend_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/time_window_access.proto",
+}
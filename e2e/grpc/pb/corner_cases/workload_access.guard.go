@@ -0,0 +1,40 @@
+// Code generated by protoc-gen-go-guard. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-guard (unknown)
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/workload_access.proto
+
+package corner_cases
+
+import (
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+var guardService_WorkloadAccess = guard.Service{
+	Name: "WorkloadAccess",
+	Methods: map[string]*guard.Method{
+		"Charge": {
+			Rules: []*guard.Rule{
+				{
+					Workload: &guard.Workload{
+						SPIFFEIDs: []string{"spiffe://prod.example.com/ns/billing/**"},
+					},
+				},
+			},
+		},
+		"Reconcile": {
+			Rules: []*guard.Rule{
+				{
+					Workload: &guard.Workload{
+						CommonNames: []string{"ops-cli"},
+						DNSNames:    []string{"*.billing.svc.cluster.local"},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (UnimplementedWorkloadAccessServer) GuardService() *guard.Service {
+	return &guardService_WorkloadAccess
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: e2e/grpc/api/corner_cases/workload_access.proto
+
+package corner_cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WorkloadAccessClient is the client API for WorkloadAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkloadAccessClient interface {
+	// Access for any workload of the billing namespace.
+	Charge(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Access for billing services by DNS name and for the ops tool by common name.
+	Reconcile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type workloadAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkloadAccessClient(cc grpc.ClientConnInterface) WorkloadAccessClient {
+	return &workloadAccessClient{cc}
+}
+
+func (c *workloadAccessClient) Charge(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.WorkloadAccess/Charge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workloadAccessClient) Reconcile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/e2e.corner_cases.WorkloadAccess/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkloadAccessServer is the server API for WorkloadAccess service.
+// All implementations must embed UnimplementedWorkloadAccessServer
+// for forward compatibility
+type WorkloadAccessServer interface {
+	// Access for any workload of the billing namespace.
+	Charge(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Access for billing services by DNS name and for the ops tool by common name.
+	Reconcile(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	mustEmbedUnimplementedWorkloadAccessServer()
+}
+
+// UnimplementedWorkloadAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedWorkloadAccessServer struct {
+}
+
+func (UnimplementedWorkloadAccessServer) Charge(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Charge not implemented")
+}
+func (UnimplementedWorkloadAccessServer) Reconcile(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedWorkloadAccessServer) mustEmbedUnimplementedWorkloadAccessServer() {}
+
+// UnsafeWorkloadAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkloadAccessServer will
+// result in compilation errors.
+type UnsafeWorkloadAccessServer interface {
+	mustEmbedUnimplementedWorkloadAccessServer()
+}
+
+func RegisterWorkloadAccessServer(s grpc.ServiceRegistrar, srv WorkloadAccessServer) {
+	s.RegisterService(&WorkloadAccess_ServiceDesc, srv)
+}
+
+func _WorkloadAccess_Charge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadAccessServer).Charge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.WorkloadAccess/Charge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadAccessServer).Charge(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkloadAccess_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadAccessServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2e.corner_cases.WorkloadAccess/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadAccessServer).Reconcile(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkloadAccess_ServiceDesc is the grpc.ServiceDesc for WorkloadAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkloadAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2e.corner_cases.WorkloadAccess",
+	HandlerType: (*WorkloadAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Charge",
+			Handler:    _WorkloadAccess_Charge_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _WorkloadAccess_Reconcile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2e/grpc/api/corner_cases/workload_access.proto",
+}
@@ -0,0 +1,24 @@
+package corner_cases
+
+import (
+	"context"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AuthenticatorAccessServer struct {
+	desc.UnimplementedAuthenticatorAccessServer
+}
+
+func (s *AuthenticatorAccessServer) GetProfile(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthenticatorAccessServer) SyncUsers(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthenticatorAccessServer) GetStatus(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,20 @@
+package corner_cases
+
+import (
+	"context"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ConditionAccessServer struct {
+	desc.UnimplementedConditionAccessServer
+}
+
+func (c *ConditionAccessServer) SameTenant(context.Context, *desc.TenantRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (c *ConditionAccessServer) AdminOrSupport(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
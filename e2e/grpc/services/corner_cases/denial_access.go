@@ -0,0 +1,20 @@
+package corner_cases
+
+import (
+	"context"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DenialAccessServer struct {
+	desc.UnimplementedDenialAccessServer
+}
+
+func (d *DenialAccessServer) Hidden(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (d *DenialAccessServer) Premium(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,24 @@
+package corner_cases
+
+import (
+	"context"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DenyAccessServer struct {
+	desc.UnimplementedDenyAccessServer
+}
+
+func (d *DenyAccessServer) Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (d *DenyAccessServer) Public(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (d *DenyAccessServer) Locked(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
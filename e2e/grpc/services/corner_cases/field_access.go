@@ -0,0 +1,37 @@
+package corner_cases
+
+import (
+	"context"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type FieldAccessServer struct {
+	desc.UnimplementedFieldAccessServer
+}
+
+func (f *FieldAccessServer) GetProfile(context.Context, *emptypb.Empty) (*desc.Profile, error) {
+	return testProfile(), nil
+}
+
+func (f *FieldAccessServer) ListProfiles(context.Context, *emptypb.Empty) (*desc.ProfileList, error) {
+	return &desc.ProfileList{
+		Profiles: []*desc.Profile{testProfile(), testProfile()},
+	}, nil
+}
+
+func (f *FieldAccessServer) UpdateProfile(context.Context, *desc.UpdateProfileRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func testProfile() *desc.Profile {
+	return &desc.Profile{
+		Name:  "name",
+		Email: "user@example.com",
+		Billing: &desc.Billing{
+			CardLast4: "4242",
+			Plan:      "premium",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package corner_cases
+
+import (
+	"context"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type FileRulesAccessServer struct {
+	desc.UnimplementedFileRulesAccessServer
+}
+
+func (f *FileRulesAccessServer) Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (f *FileRulesAccessServer) Public(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+type FileRulesOverrideAccessServer struct {
+	desc.UnimplementedFileRulesOverrideAccessServer
+}
+
+func (f *FileRulesOverrideAccessServer) Read(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
@@ -30,3 +30,15 @@ func (i InheritAndOverrideTwoServer) InheritedMethod(context.Context, *emptypb.E
 func (i InheritAndOverrideTwoServer) OverriddenMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
+
+type InheritAndOverrideThreeServer struct {
+	desc.UnimplementedInheritAndOverrideThreeServer
+}
+
+func (i InheritAndOverrideThreeServer) AppendedMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (i InheritAndOverrideThreeServer) IntersectedMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
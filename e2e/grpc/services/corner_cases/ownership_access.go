@@ -0,0 +1,24 @@
+package corner_cases
+
+import (
+	"context"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type OwnershipAccessServer struct {
+	desc.UnimplementedOwnershipAccessServer
+}
+
+func (o *OwnershipAccessServer) GetOrder(context.Context, *desc.GetOrderRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (o *OwnershipAccessServer) DeleteOrder(context.Context, *desc.DeleteOrderRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (o *OwnershipAccessServer) CancelOrder(context.Context, *desc.GetOrderRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
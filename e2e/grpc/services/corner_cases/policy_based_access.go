@@ -26,3 +26,11 @@ func (p *PolicyBasedAccessServer) MultiplePoliciesWithAnyRequirement(context.Con
 func (p *PolicyBasedAccessServer) MultiplePoliciesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
+
+func (p *PolicyBasedAccessServer) PolicyWithLowLimitArgument(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (p *PolicyBasedAccessServer) PolicyWithHighLimitArgument(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
@@ -26,3 +26,11 @@ func (a *RoleBasedAccessServer) MultipleRolesWithAnyRequirement(context.Context,
 func (a *RoleBasedAccessServer) MultipleRolesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
+
+func (a *RoleBasedAccessServer) MultipleRolesWithNoneRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (a *RoleBasedAccessServer) MultipleRolesWithAtLeastRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
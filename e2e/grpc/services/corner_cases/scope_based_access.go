@@ -0,0 +1,20 @@
+package corner_cases
+
+import (
+	"context"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ScopeBasedAccessServer struct {
+	desc.UnimplementedScopeBasedAccessServer
+}
+
+func (s *ScopeBasedAccessServer) MultipleScopesWithAllRequirement(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ScopeBasedAccessServer) RoleAndScope(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,114 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AuthenticatorAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.AuthenticatorAccessClient
+}
+
+func (s *AuthenticatorAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterAuthenticatorAccessServer(s.server, &services.AuthenticatorAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewAuthenticatorAccessClient(client)
+}
+
+func (s *AuthenticatorAccessTestsSuite) TestAuthenticators() {
+	var (
+		user    = testContextWithSubject(interceptor.Subject{Roles: []string{"user"}})
+		admin   = testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}})
+		service = metadata.AppendToOutgoingContext(context.Background(), testServiceHeader, "billing")
+	)
+
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "user accepted by service authenticators",
+			context:      user,
+			call:         s.client.GetProfile,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "service not accepted by service authenticators",
+			context:      service,
+			call:         s.client.GetProfile,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "service accepted by method authenticators",
+			context:      service,
+			call:         s.client.SyncUsers,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "admin not accepted by method authenticators",
+			context:      admin,
+			call:         s.client.SyncUsers,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "invalid service credentials",
+			context:      metadata.AppendToOutgoingContext(context.Background(), testServiceHeader, ""),
+			call:         s.client.SyncUsers,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "service allowed by authentication scheme",
+			context:      service,
+			call:         s.client.GetStatus,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "admin allowed by role",
+			context:      admin,
+			call:         s.client.GetStatus,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "user denied",
+			context:      user,
+			call:         s.client.GetStatus,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAuthenticatorAccessTests(t *testing.T) {
+	suite.Run(t, new(AuthenticatorAccessTestsSuite))
+}
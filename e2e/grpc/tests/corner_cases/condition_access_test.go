@@ -0,0 +1,126 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ConditionAccessServerTestSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.ConditionAccessClient
+}
+
+func (s *ConditionAccessServerTestSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterConditionAccessServer(s.server, &services.ConditionAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewConditionAccessClient(client)
+}
+
+func (s *ConditionAccessServerTestSuite) TestSameTenant() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		tenantID     string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for unauthenticated",
+			context:      context.Background(),
+			tenantID:     "tenant-1",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access denied for authenticated without tenant",
+			context:      testContextWithSubject(interceptor.Subject{}),
+			tenantID:     "tenant-1",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated from another tenant",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"tenant_id": "tenant-2"}}),
+			tenantID:     "tenant-1",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated from the same tenant",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"tenant_id": "tenant-1"}}),
+			tenantID:     "tenant-1",
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.SameTenant(tt.context, &desc.TenantRequest{TenantId: tt.tenantID})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (s *ConditionAccessServerTestSuite) TestAdminOrSupport() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for unauthenticated",
+			context:      context.Background(),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access denied for authenticated without admin role",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated with admin role",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}}),
+			expectedCode: codes.OK,
+		},
+		{
+			name: "access allowed for authenticated with support header",
+			context: metadata.AppendToOutgoingContext(
+				testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+				"x-support", "1",
+			),
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.AdminOrSupport(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestConditionAccessServer(t *testing.T) {
+	suite.Run(t, new(ConditionAccessServerTestSuite))
+}
@@ -4,16 +4,36 @@ package corner_cases
 
 import (
 	"context"
+	"fmt"
 	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/test/bufconn"
 )
 
+// testNow is the current time of the test server: Wednesday, 10:30 UTC.
+var testNow = time.Date(2025, time.March, 5, 10, 30, 0, 0, time.UTC)
+
+// testTrustedProxies are proxies the test server accepts x-forwarded-for from.
+var testTrustedProxies = []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")}
+
+// testServiceHeader is the metadata key carrying the name of the calling internal service,
+// authenticated by the "service" authenticator.
+const testServiceHeader = "x-test-service"
+
+// testPeerHeader is the metadata key the test server takes the client address from,
+// as requests over in-memory connections have none.
+const testPeerHeader = "x-test-peer"
+
 type CornerCasesServerTestSuite struct {
 	suite.Suite
 
@@ -22,17 +42,28 @@ type CornerCasesServerTestSuite struct {
 }
 
 func (g *CornerCasesServerTestSuite) SetupSuite() {
+	g.SetupServer()
+}
+
+// SetupServer creates the test server with the guard interceptor and additional options.
+func (g *CornerCasesServerTestSuite) SetupServer(opts ...grpc.ServerOption) {
 	const bufferSize = 1024 * 1024
 	g.listener = bufconn.Listen(bufferSize)
 
-	g.server = grpc.NewServer(
-		grpc.UnaryInterceptor(
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			testPeerInterceptor(),
 			interceptor.New(
 				testSubjectResolver(),
 				interceptor.WithPolicies(testPolicies()),
+				interceptor.WithAuthenticators(testAuthenticators()),
+				interceptor.WithClock(func() time.Time { return testNow }),
+				interceptor.WithTrustedProxies(testTrustedProxies...),
 			).Unary(),
 		),
-	)
+	}, opts...)
+
+	g.server = grpc.NewServer(opts...)
 }
 
 func (g *CornerCasesServerTestSuite) TearDownSuite() {
@@ -53,14 +84,27 @@ func (g *CornerCasesServerTestSuite) StartServer() {
 	}()
 }
 
-func (g *CornerCasesServerTestSuite) GetClientConn() (*grpc.ClientConn, error) {
-	return grpc.NewClient(
-		"passthrough://bufnet",
+// GetClientConn dials the test server with insecure credentials unless overridden by options.
+func (g *CornerCasesServerTestSuite) GetClientConn(opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{
 		grpc.WithContextDialer(func(_ context.Context, _ string) (net.Conn, error) {
 			return g.listener.Dial()
 		}),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	}, opts...)
+
+	return grpc.NewClient("passthrough://bufnet", opts...)
+}
+
+// testPeerInterceptor replaces the client address with the one passed in testPeerHeader.
+func testPeerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if values := metadata.ValueFromIncomingContext(ctx, testPeerHeader); len(values) > 0 {
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(values[0])}})
+		}
+
+		return handler(ctx, req)
+	}
 }
 
 func testSubjectResolver() interceptor.SubjectResolver {
@@ -79,14 +123,78 @@ func testSubjectResolver() interceptor.SubjectResolver {
 			subject.Roles = roles
 		}
 
+		if scopes, exists := md["scopes"]; exists {
+			subject.Scopes = scopes
+		}
+
+		if levels := md["auth-level"]; len(levels) > 0 {
+			subject.AuthLevel, _ = strconv.Atoi(levels[0])
+		}
+
+		if times := md["authenticated-at"]; len(times) > 0 {
+			subject.AuthenticatedAt, _ = time.Parse(time.RFC3339, times[0])
+		}
+
+		for key, values := range md {
+			if attr, found := strings.CutPrefix(key, "attr-"); found && len(values) > 0 {
+				if subject.Attrs == nil {
+					subject.Attrs = make(map[string]any)
+				}
+
+				// Integer attributes are passed with the "int-" prefix.
+				if name, integer := strings.CutPrefix(attr, "int-"); integer {
+					subject.Attrs[name], _ = strconv.ParseInt(values[0], 10, 64)
+				} else {
+					subject.Attrs[attr] = values[0]
+				}
+			}
+		}
+
 		return &subject, nil
 	}
 }
 
+// testAuthenticators authenticates end users as testSubjectResolver does,
+// and internal services by the name passed in testServiceHeader.
+func testAuthenticators() interceptor.Authenticators {
+	return interceptor.Authenticators{
+		"user": testSubjectResolver(),
+		"service": func(ctx context.Context) (*interceptor.Subject, error) {
+			values := metadata.ValueFromIncomingContext(ctx, testServiceHeader)
+			if len(values) == 0 {
+				return nil, nil
+			}
+
+			if values[0] == "" {
+				return nil, fmt.Errorf("%w: empty service name", interceptor.ErrInvalidCredentials)
+			}
+
+			return &interceptor.Subject{Attrs: map[string]any{"service": values[0]}}, nil
+		},
+	}
+}
+
 func testContextWithSubject(subject interceptor.Subject) context.Context {
 	md := metadata.MD{}
 	md.Append("authenticated", "1")
 	md.Append("roles", subject.Roles...)
+	md.Append("scopes", subject.Scopes...)
+
+	if subject.AuthLevel != 0 {
+		md.Append("auth-level", strconv.Itoa(subject.AuthLevel))
+	}
+
+	if !subject.AuthenticatedAt.IsZero() {
+		md.Append("authenticated-at", subject.AuthenticatedAt.Format(time.RFC3339))
+	}
+
+	for key, value := range subject.Attrs {
+		if integer, ok := value.(int64); ok {
+			md.Append("attr-int-"+key, strconv.FormatInt(integer, 10))
+		} else {
+			md.Append("attr-"+key, fmt.Sprint(value))
+		}
+	}
 
 	return metadata.NewOutgoingContext(context.Background(), md)
 }
@@ -102,5 +210,15 @@ func testPolicies() interceptor.Policies {
 		"negative-policy-1": func(ctx context.Context, input *interceptor.Input) (bool, error) {
 			return false, nil
 		},
+		"quota": func(ctx context.Context, input *interceptor.Input) (bool, error) {
+			usage, err := strconv.ParseFloat(fmt.Sprint(input.Subject.Attrs["usage"]), 64)
+			if err != nil {
+				return false, nil
+			}
+
+			limit, _ := input.Args["limit"].(float64)
+
+			return usage < limit, nil
+		},
 	}
 }
@@ -0,0 +1,95 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DenialAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.DenialAccessClient
+}
+
+func (s *DenialAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterDenialAccessServer(s.server, &services.DenialAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewDenialAccessClient(client)
+}
+
+func (s *DenialAccessTestsSuite) TestDenials() {
+	testCases := []struct {
+		name            string
+		context         context.Context
+		call            func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:         "access allowed for admin",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}}),
+			call:         s.client.Hidden,
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "method denial for user",
+			context:         testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:            s.client.Hidden,
+			expectedCode:    codes.NotFound,
+			expectedMessage: "NotFound",
+		},
+		{
+			name:            "method denial for unauthenticated",
+			context:         context.Background(),
+			call:            s.client.Hidden,
+			expectedCode:    codes.NotFound,
+			expectedMessage: "NotFound",
+		},
+		{
+			name:         "access allowed for premium",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"premium"}}),
+			call:         s.client.Premium,
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "rule denial overrides method denial",
+			context:         testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:            s.client.Premium,
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: "upgrade required",
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+				s.Equal(tt.expectedMessage, status.Convert(err).Message())
+				s.Empty(status.Convert(err).Details())
+			}
+		})
+	}
+}
+
+func TestDenialAccessTests(t *testing.T) {
+	suite.Run(t, new(DenialAccessTestsSuite))
+}
@@ -0,0 +1,95 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DenyAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.DenyAccessClient
+}
+
+func (s *DenyAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterDenyAccessServer(s.server, &services.DenyAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewDenyAccessClient(client)
+}
+
+func (s *DenyAccessTestsSuite) TestDenyRules() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed for user",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:         s.client.Read,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for suspended user",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user", "suspended"}}),
+			call:         s.client.Read,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for unauthenticated to public method",
+			context:      context.Background(),
+			call:         s.client.Public,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for suspended user to public method",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"suspended"}}),
+			call:         s.client.Public,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for user to locked method",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:         s.client.Locked,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for unauthenticated to locked method",
+			context:      context.Background(),
+			call:         s.client.Locked,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestDenyAccessTests(t *testing.T) {
+	suite.Run(t, new(DenyAccessTestsSuite))
+}
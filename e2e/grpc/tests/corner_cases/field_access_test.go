@@ -0,0 +1,151 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type FieldAccessServerTestSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.FieldAccessClient
+}
+
+func (s *FieldAccessServerTestSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterFieldAccessServer(s.server, &services.FieldAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewFieldAccessClient(client)
+}
+
+func (s *FieldAccessServerTestSuite) TestGetProfile() {
+	testCases := []struct {
+		name     string
+		context  context.Context
+		expected *desc.Profile
+	}{
+		{
+			name:    "guarded fields cleared for unauthenticated",
+			context: context.Background(),
+			expected: &desc.Profile{
+				Name:    "name",
+				Billing: &desc.Billing{Plan: "premium"},
+			},
+		},
+		{
+			name:    "billing fields cleared for authenticated without billing role",
+			context: testContextWithSubject(interceptor.Subject{}),
+			expected: &desc.Profile{
+				Name:    "name",
+				Email:   "user@example.com",
+				Billing: &desc.Billing{Plan: "premium"},
+			},
+		},
+		{
+			name:    "all fields visible for authenticated with billing role",
+			context: testContextWithSubject(interceptor.Subject{Roles: []string{"billing"}}),
+			expected: &desc.Profile{
+				Name:    "name",
+				Email:   "user@example.com",
+				Billing: &desc.Billing{CardLast4: "4242", Plan: "premium"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			profile, err := s.client.GetProfile(tt.context, &emptypb.Empty{})
+			s.Require().NoError(err)
+			s.True(proto.Equal(tt.expected, profile), "got %v", profile)
+		})
+	}
+}
+
+func (s *FieldAccessServerTestSuite) TestListProfiles() {
+	profiles, err := s.client.ListProfiles(context.Background(), &emptypb.Empty{})
+	s.Require().NoError(err)
+	s.Require().Len(profiles.GetProfiles(), 2)
+
+	for _, profile := range profiles.GetProfiles() {
+		s.Empty(profile.GetEmail())
+		s.Empty(profile.GetBilling().GetCardLast4())
+		s.Equal("name", profile.GetName())
+	}
+}
+
+func (s *FieldAccessServerTestSuite) TestUpdateProfile() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		request      *desc.UpdateProfileRequest
+		expectedCode codes.Code
+	}{
+		{
+			name:         "unprotected field set by user",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			request:      &desc.UpdateProfileRequest{Profile: &desc.Profile{Name: "name"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "protected field set by user",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			request:      &desc.UpdateProfileRequest{Profile: &desc.Profile{Role: "admin"}},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "protected field set by admin",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}}),
+			request:      &desc.UpdateProfileRequest{Profile: &desc.Profile{Role: "admin"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:    "protected field outside of field mask set by user",
+			context: testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			request: &desc.UpdateProfileRequest{
+				Profile:    &desc.Profile{Name: "name", Role: "admin"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:    "protected field cleared through field mask by user",
+			context: testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			request: &desc.UpdateProfileRequest{
+				Profile:    &desc.Profile{},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.UpdateProfile(tt.context, tt.request)
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestFieldAccessServer(t *testing.T) {
+	suite.Run(t, new(FieldAccessServerTestSuite))
+}
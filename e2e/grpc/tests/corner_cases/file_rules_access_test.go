@@ -0,0 +1,98 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type FileRulesAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client         desc.FileRulesAccessClient
+	overrideClient desc.FileRulesOverrideAccessClient
+}
+
+func (s *FileRulesAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterFileRulesAccessServer(s.server, &services.FileRulesAccessServer{})
+	desc.RegisterFileRulesOverrideAccessServer(s.server, &services.FileRulesOverrideAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewFileRulesAccessClient(client)
+	s.overrideClient = desc.NewFileRulesOverrideAccessClient(client)
+}
+
+func (s *FileRulesAccessTestsSuite) TestFileRules() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed for user to method inheriting file rules",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:         s.client.Read,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for guest to method inheriting file rules",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"guest"}}),
+			call:         s.client.Read,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for unauthenticated to method inheriting file rules",
+			context:      context.Background(),
+			call:         s.client.Read,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access allowed for unauthenticated to method overriding file rules",
+			context:      context.Background(),
+			call:         s.client.Public,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for user to service overriding file rules",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:         s.overrideClient.Read,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for admin to service overriding file rules",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}}),
+			call:         s.overrideClient.Read,
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestFileRulesAccessTests(t *testing.T) {
+	suite.Run(t, new(FileRulesAccessTestsSuite))
+}
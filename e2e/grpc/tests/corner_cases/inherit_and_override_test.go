@@ -159,6 +159,98 @@ func (s *InheritAndOverrideTwoServerTestSuite) TestOverriddenMethod() {
 	}
 }
 
+type InheritAndOverrideThreeServerTestSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.InheritAndOverrideThreeClient
+}
+
+func (s *InheritAndOverrideThreeServerTestSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterInheritAndOverrideThreeServer(s.server, &services.InheritAndOverrideThreeServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewInheritAndOverrideThreeClient(client)
+}
+
+func (s *InheritAndOverrideThreeServerTestSuite) TestAppendedMethod() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed for authenticated with service role",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access allowed for authenticated with method role",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"support"}}),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for authenticated without roles",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"guest"}}),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for unauthenticated",
+			context:      context.Background(),
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.AppendedMethod(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (s *InheritAndOverrideThreeServerTestSuite) TestIntersectedMethod() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed for authenticated with service role and method scope",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}, Scopes: []string{"write"}}),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for authenticated with service role only",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated with method scope only",
+			context:      testContextWithSubject(interceptor.Subject{Scopes: []string{"write"}}),
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.IntersectedMethod(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
 func TestInheritAndOverrideOneServer(t *testing.T) {
 	suite.Run(t, new(InheritAndOverrideOneServerTestSuite))
 }
@@ -166,3 +258,7 @@ func TestInheritAndOverrideOneServer(t *testing.T) {
 func TestInheritAndOverrideTwoServer(t *testing.T) {
 	suite.Run(t, new(InheritAndOverrideTwoServerTestSuite))
 }
+
+func TestInheritAndOverrideThreeServer(t *testing.T) {
+	suite.Run(t, new(InheritAndOverrideThreeServerTestSuite))
+}
@@ -0,0 +1,125 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type MetadataAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.MetadataAccessClient
+}
+
+func (s *MetadataAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterMetadataAccessServer(s.server, &services.MetadataAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewMetadataAccessClient(client)
+}
+
+func (s *MetadataAccessTestsSuite) TestMetadata() {
+	user := interceptor.Subject{Roles: []string{"user"}}
+	admin := interceptor.Subject{Roles: []string{"admin"}}
+
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed from allowed client app",
+			context:      metadata.AppendToOutgoingContext(testContextWithSubject(user), "x-client-app", "ios"),
+			call:         s.client.ClientApps,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied from other client app",
+			context:      metadata.AppendToOutgoingContext(testContextWithSubject(user), "x-client-app", "web"),
+			call:         s.client.ClientApps,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied without client app",
+			context:      testContextWithSubject(user),
+			call:         s.client.ClientApps,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied from allowed client app without authentication",
+			context:      metadata.AppendToOutgoingContext(context.Background(), "x-client-app", "android"),
+			call:         s.client.ClientApps,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access allowed for admin with reason",
+			context:      metadata.AppendToOutgoingContext(testContextWithSubject(admin), "x-request-reason", "incident"),
+			call:         s.client.Support,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for admin without reason",
+			context:      testContextWithSubject(admin),
+			call:         s.client.Support,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "access denied for admin with reason in debug session",
+			context: metadata.AppendToOutgoingContext(
+				testContextWithSubject(admin),
+				"x-request-reason", "incident",
+				"x-debug", "1",
+			),
+			call:         s.client.Support,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (s *MetadataAccessTestsSuite) TestErrorDetails() {
+	ctx := metadata.AppendToOutgoingContext(testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}), "x-client-app", "web")
+
+	_, err := s.client.ClientApps(ctx, &emptypb.Empty{})
+	s.Require().Equal(codes.PermissionDenied, status.Code(err))
+
+	details := status.Convert(err).Details()
+	s.Require().Len(details, 1)
+
+	info, ok := details[0].(*errdetails.ErrorInfo)
+	s.Require().True(ok)
+	s.Equal(interceptor.ReasonMetadataMismatch, info.GetReason())
+	s.Equal("x-client-app", info.GetMetadata()[interceptor.MetadataKey])
+}
+
+func TestMetadataAccessTests(t *testing.T) {
+	suite.Run(t, new(MetadataAccessTestsSuite))
+}
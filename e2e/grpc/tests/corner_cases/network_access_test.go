@@ -0,0 +1,120 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type NetworkAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.NetworkAccessClient
+}
+
+func (s *NetworkAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterNetworkAccessServer(s.server, &services.NetworkAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewNetworkAccessClient(client)
+}
+
+func (s *NetworkAccessTestsSuite) TestNetworks() {
+	admin := interceptor.Subject{Roles: []string{"admin"}}
+
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed for admin from vpn",
+			context:      testContextWithPeer(testContextWithSubject(admin), "10.8.1.2"),
+			call:         s.client.Internal,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for admin from outside of vpn",
+			context:      testContextWithPeer(testContextWithSubject(admin), "198.51.100.7"),
+			call:         s.client.Internal,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for admin from unknown address",
+			context:      testContextWithSubject(admin),
+			call:         s.client.Internal,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for user from vpn",
+			context:      testContextWithPeer(testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}), "10.8.1.2"),
+			call:         s.client.Internal,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "access allowed for admin from vpn through trusted proxy",
+			context: metadata.AppendToOutgoingContext(
+				testContextWithPeer(testContextWithSubject(admin), "192.0.2.1"),
+				"x-forwarded-for", "10.8.1.2",
+			),
+			call:         s.client.Internal,
+			expectedCode: codes.OK,
+		},
+		{
+			name: "access denied for admin with forwarded address from untrusted proxy",
+			context: metadata.AppendToOutgoingContext(
+				testContextWithPeer(testContextWithSubject(admin), "198.51.100.7"),
+				"x-forwarded-for", "10.8.1.2",
+			),
+			call:         s.client.Internal,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed from not blocked range",
+			context:      testContextWithPeer(context.Background(), "198.51.100.7"),
+			call:         s.client.Blocklist,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied from blocked range",
+			context:      testContextWithPeer(context.Background(), "203.0.113.5"),
+			call:         s.client.Blocklist,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func testContextWithPeer(ctx context.Context, addr string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, testPeerHeader, addr)
+}
+
+func TestNetworkAccessTests(t *testing.T) {
+	suite.Run(t, new(NetworkAccessTestsSuite))
+}
@@ -0,0 +1,177 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OwnershipAccessServerTestSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.OwnershipAccessClient
+}
+
+func (s *OwnershipAccessServerTestSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterOwnershipAccessServer(s.server, &services.OwnershipAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewOwnershipAccessClient(client)
+}
+
+func (s *OwnershipAccessServerTestSuite) TestGetOrder() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		userID       string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for unauthenticated",
+			context:      context.Background(),
+			userID:       "user-1",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access denied for authenticated without id",
+			context:      testContextWithSubject(interceptor.Subject{}),
+			userID:       "user-1",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated not owner",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"id": "user-2"}}),
+			userID:       "user-1",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for empty request field",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"id": "user-1"}}),
+			userID:       "",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated owner",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"id": "user-1"}}),
+			userID:       "user-1",
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.GetOrder(tt.context, &desc.GetOrderRequest{UserId: tt.userID, OrderId: "order-1"})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (s *OwnershipAccessServerTestSuite) TestDeleteOrder() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		owner        *desc.Owner
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for authenticated owner without role",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"id": int64(42)}}),
+			owner:        &desc.Owner{Id: 42},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated with role not owner",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}, Attrs: map[string]any{"id": int64(7)}}),
+			owner:        &desc.Owner{Id: 42},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated with role without owner in request",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}, Attrs: map[string]any{"id": int64(42)}}),
+			owner:        nil,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated with role and string owner attribute",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}, Attrs: map[string]any{"id": "42"}}),
+			owner:        &desc.Owner{Id: 42},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated owner with role",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}, Attrs: map[string]any{"id": int64(42)}}),
+			owner:        &desc.Owner{Id: 42},
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.DeleteOrder(tt.context, &desc.DeleteOrderRequest{Owner: tt.owner, OrderId: "order-1"})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (s *OwnershipAccessServerTestSuite) TestCancelOrder() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		userID       string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for authenticated neither admin nor owner",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}, Attrs: map[string]any{"id": "user-2"}}),
+			userID:       "user-1",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated owner without admin role",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}, Attrs: map[string]any{"id": "user-1"}}),
+			userID:       "user-1",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access allowed for authenticated admin not owner",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}, Attrs: map[string]any{"id": "user-2"}}),
+			userID:       "user-1",
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.CancelOrder(tt.context, &desc.GetOrderRequest{UserId: tt.userID, OrderId: "order-1"})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestOwnershipAccessServer(t *testing.T) {
+	suite.Run(t, new(OwnershipAccessServerTestSuite))
+}
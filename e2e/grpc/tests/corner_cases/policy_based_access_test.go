@@ -10,6 +10,7 @@ import (
 	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
 	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -107,6 +108,45 @@ func (s *PolicyBasedAccessTestsSuite) TestMultiplePoliciesWithAnyRequirement() {
 	}
 }
 
+func (s *PolicyBasedAccessTestsSuite) TestPolicyWithArguments() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for authenticated over the low limit",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"usage": 50}}),
+			call:         s.client.PolicyWithLowLimitArgument,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated under the high limit",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"usage": 50}}),
+			call:         s.client.PolicyWithHighLimitArgument,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access allowed for authenticated under the low limit",
+			context:      testContextWithSubject(interceptor.Subject{Attrs: map[string]any{"usage": 5}}),
+			call:         s.client.PolicyWithLowLimitArgument,
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
 func TestPolicyBasedAccessTests(t *testing.T) {
 	suite.Run(t, new(PolicyBasedAccessTestsSuite))
 }
@@ -10,6 +10,7 @@ import (
 	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
 	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -170,6 +171,122 @@ func (s *RoleBasedAccessServerTestSuite) TestMultipleRolesWithAllRequirement() {
 	}
 }
 
+func (s *RoleBasedAccessServerTestSuite) TestErrorDetails() {
+	testCases := []struct {
+		name             string
+		context          context.Context
+		expectedReason   string
+		expectedMetadata map[string]string
+	}{
+		{
+			name:             "unauthenticated",
+			context:          context.Background(),
+			expectedReason:   interceptor.ReasonUnauthenticated,
+			expectedMetadata: map[string]string{interceptor.MetadataRule: "authenticated"},
+		},
+		{
+			name: "missing role",
+			context: testContextWithSubject(interceptor.Subject{
+				Roles: []string{"admin"},
+			}),
+			expectedReason: interceptor.ReasonMissingRole,
+			expectedMetadata: map[string]string{
+				interceptor.MetadataRule:         "role-based",
+				interceptor.MetadataMissingRoles: "manager",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.MultipleRolesWithAllRequirement(tt.context, &emptypb.Empty{})
+			s.Require().Error(err)
+
+			details := status.Convert(err).Details()
+			s.Require().NotEmpty(details)
+
+			info, ok := details[0].(*errdetails.ErrorInfo)
+			s.Require().True(ok)
+			s.Equal(tt.expectedReason, info.GetReason())
+			s.Equal(interceptor.DefaultErrorDomain, info.GetDomain())
+			s.Equal(tt.expectedMetadata, info.GetMetadata())
+		})
+	}
+}
+
+func (s *RoleBasedAccessServerTestSuite) TestMultipleRolesWithNoneRequirement() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied without token",
+			context:      context.Background(),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name: "access allowed with token and without listed roles",
+			context: testContextWithSubject(interceptor.Subject{
+				Roles: []string{"user"},
+			}),
+			expectedCode: codes.OK,
+		},
+		{
+			name: "access denied with token and with one listed role",
+			context: testContextWithSubject(interceptor.Subject{
+				Roles: []string{"user", "banned"},
+			}),
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.MultipleRolesWithNoneRequirement(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (s *RoleBasedAccessServerTestSuite) TestMultipleRolesWithAtLeastRequirement() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		expectedCode codes.Code
+	}{
+		{
+			name: "access denied with token and with one required role",
+			context: testContextWithSubject(interceptor.Subject{
+				Roles: []string{"approver"},
+			}),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "access allowed with token and with two required roles",
+			context: testContextWithSubject(interceptor.Subject{
+				Roles: []string{"approver", "auditor"},
+			}),
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.MultipleRolesWithAtLeastRequirement(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
 func TestRoleBasedAccessServer(t *testing.T) {
 	suite.Run(t, new(RoleBasedAccessServerTestSuite))
 }
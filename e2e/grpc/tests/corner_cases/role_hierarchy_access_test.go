@@ -0,0 +1,89 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type RoleHierarchyAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.RoleHierarchyAccessClient
+}
+
+func (s *RoleHierarchyAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterRoleHierarchyAccessServer(s.server, &services.RoleHierarchyAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewRoleHierarchyAccessClient(client)
+}
+
+func (s *RoleHierarchyAccessTestsSuite) TestRoleHierarchy() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed for viewer to view",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"viewer"}}),
+			call:         s.client.View,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for viewer to edit",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"viewer"}}),
+			call:         s.client.Edit,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for editor to view",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"editor"}}),
+			call:         s.client.View,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access allowed for admin to view transitively",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}}),
+			call:         s.client.View,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access allowed for admin to edit",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}}),
+			call:         s.client.Edit,
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestRoleHierarchyAccessTests(t *testing.T) {
+	suite.Run(t, new(RoleHierarchyAccessTestsSuite))
+}
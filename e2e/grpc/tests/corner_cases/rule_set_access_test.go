@@ -0,0 +1,101 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type RuleSetAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.RuleSetAccessClient
+}
+
+func (s *RuleSetAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterRuleSetAccessServer(s.server, &services.RuleSetAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewRuleSetAccessClient(client)
+}
+
+func (s *RuleSetAccessTestsSuite) TestRuleSets() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed for support to service rule set",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"support"}}),
+			call:         s.client.Read,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for user to service rule set",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:         s.client.Read,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for admin to nested rule set",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}}),
+			call:         s.client.Manage,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access allowed for client with scope to nested rule set",
+			context:      testContextWithSubject(interceptor.Subject{Scopes: []string{"backoffice"}}),
+			call:         s.client.Manage,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for user without scope to nested rule set",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:         s.client.Manage,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for auditor to rule set with inline rule",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"auditor"}}),
+			call:         s.client.Audit,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access allowed for admin to rule set with inline rule",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}}),
+			call:         s.client.Audit,
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestRuleSetAccessTests(t *testing.T) {
+	suite.Run(t, new(RuleSetAccessTestsSuite))
+}
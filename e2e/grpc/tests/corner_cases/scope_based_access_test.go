@@ -0,0 +1,112 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ScopeBasedAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.ScopeBasedAccessClient
+}
+
+func (s *ScopeBasedAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterScopeBasedAccessServer(s.server, &services.ScopeBasedAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewScopeBasedAccessClient(client)
+}
+
+func (s *ScopeBasedAccessTestsSuite) TestMultipleScopesWithAllRequirement() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for unauthenticated",
+			context:      context.Background(),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access denied for authenticated with required scopes as roles",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"orders:read", "orders:write"}}),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated with not all scopes",
+			context:      testContextWithSubject(interceptor.Subject{Scopes: []string{"orders:read"}}),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated with all scopes",
+			context:      testContextWithSubject(interceptor.Subject{Scopes: []string{"orders:read", "orders:write"}}),
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.MultipleScopesWithAllRequirement(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (s *ScopeBasedAccessTestsSuite) TestRoleAndScope() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for authenticated with role without scope",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"manager"}}),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated with scope without role",
+			context:      testContextWithSubject(interceptor.Subject{Scopes: []string{"orders:read"}}),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated with role and scope",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"manager"}, Scopes: []string{"orders:read"}}),
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.RoleAndScope(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestScopeBasedAccessTests(t *testing.T) {
+	suite.Run(t, new(ScopeBasedAccessTestsSuite))
+}
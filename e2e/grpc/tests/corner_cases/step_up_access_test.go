@@ -0,0 +1,140 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type StepUpAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.StepUpAccessClient
+}
+
+func (s *StepUpAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterStepUpAccessServer(s.server, &services.StepUpAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewStepUpAccessClient(client)
+}
+
+func (s *StepUpAccessTestsSuite) TestStepUp() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access allowed after recent mfa",
+			context:      testContextWithSubject(interceptor.Subject{AuthLevel: 2, AuthenticatedAt: testNow.Add(-time.Minute)}),
+			call:         s.client.DeleteProfile,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied after old mfa",
+			context:      testContextWithSubject(interceptor.Subject{AuthLevel: 2, AuthenticatedAt: testNow.Add(-time.Hour)}),
+			call:         s.client.DeleteProfile,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access denied after recent password authentication",
+			context:      testContextWithSubject(interceptor.Subject{AuthLevel: 1, AuthenticatedAt: testNow.Add(-time.Minute)}),
+			call:         s.client.DeleteProfile,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access denied for unauthenticated",
+			context:      context.Background(),
+			call:         s.client.DeleteProfile,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access allowed for admin after mfa",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}, AuthLevel: 2}),
+			call:         s.client.RotateKeys,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for admin without mfa",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"admin"}, AuthLevel: 1}),
+			call:         s.client.RotateKeys,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access denied for user after mfa",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}, AuthLevel: 2}),
+			call:         s.client.RotateKeys,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (s *StepUpAccessTestsSuite) TestErrorDetails() {
+	testCases := []struct {
+		name             string
+		subject          interceptor.Subject
+		expectedReason   string
+		expectedMetadata map[string]string
+	}{
+		{
+			name:             "insufficient auth level",
+			subject:          interceptor.Subject{AuthLevel: 1, AuthenticatedAt: testNow},
+			expectedReason:   interceptor.ReasonAuthLevelTooLow,
+			expectedMetadata: map[string]string{interceptor.MetadataRule: "auth-level", interceptor.MetadataAuthLevel: "2"},
+		},
+		{
+			name:             "authentication too old",
+			subject:          interceptor.Subject{AuthLevel: 2, AuthenticatedAt: testNow.Add(-time.Hour)},
+			expectedReason:   interceptor.ReasonAuthTooOld,
+			expectedMetadata: map[string]string{interceptor.MetadataRule: "auth-age", interceptor.MetadataMaxAuthAge: "300"},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := s.client.DeleteProfile(testContextWithSubject(tt.subject), &emptypb.Empty{})
+			s.Require().Equal(codes.Unauthenticated, status.Code(err))
+
+			details := status.Convert(err).Details()
+			s.Require().Len(details, 1)
+
+			info, ok := details[0].(*errdetails.ErrorInfo)
+			s.Require().True(ok)
+			s.Equal(tt.expectedReason, info.GetReason())
+			s.Equal(tt.expectedMetadata, info.GetMetadata())
+		})
+	}
+}
+
+func TestStepUpAccessTests(t *testing.T) {
+	suite.Run(t, new(StepUpAccessTestsSuite))
+}
@@ -0,0 +1,96 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"testing"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type TimeWindowAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	client desc.TimeWindowAccessClient
+}
+
+func (s *TimeWindowAccessTestsSuite) SetupSuite() {
+	s.CornerCasesServerTestSuite.SetupSuite()
+	desc.RegisterTimeWindowAccessServer(s.server, &services.TimeWindowAccessServer{})
+	s.StartServer()
+
+	client, err := s.GetClientConn()
+	s.Require().NoError(err, "Failed to dial test server")
+
+	s.client = desc.NewTimeWindowAccessClient(client)
+}
+
+// TestTimeWindows runs at testNow: Wednesday, 11:30 in Berlin.
+func (s *TimeWindowAccessTestsSuite) TestTimeWindows() {
+	testCases := []struct {
+		name         string
+		context      context.Context
+		call         func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "access denied for unauthenticated after validity period",
+			context:      context.Background(),
+			call:         s.client.Expired,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access allowed for authenticated within validity period",
+			context:      testContextWithSubject(interceptor.Subject{}),
+			call:         s.client.Migration,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for unauthenticated within validity period",
+			context:      context.Background(),
+			call:         s.client.Migration,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "access allowed for backoffice during business hours",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"backoffice"}}),
+			call:         s.client.BusinessHours,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "access denied for user during business hours",
+			context:      testContextWithSubject(interceptor.Subject{Roles: []string{"user"}}),
+			call:         s.client.BusinessHours,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "access denied for authenticated on weekday",
+			context:      testContextWithSubject(interceptor.Subject{}),
+			call:         s.client.Weekend,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(tt.context, &emptypb.Empty{})
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestTimeWindowAccessTests(t *testing.T) {
+	suite.Run(t, new(TimeWindowAccessTestsSuite))
+}
@@ -0,0 +1,239 @@
+//go:build e2e
+
+package corner_cases
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/pb/corner_cases"
+	services "github.com/casnerano/protoc-gen-go-guard/e2e/grpc/services/corner_cases"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type WorkloadAccessTestsSuite struct {
+	CornerCasesServerTestSuite
+
+	// clients holds a client per workload identity; "" has no client certificate.
+	clients map[string]desc.WorkloadAccessClient
+}
+
+// testWorkloads are client certificate templates of calling services, by name.
+var testWorkloads = map[string]*x509.Certificate{
+	"billing-worker": {
+		Subject:  pkix.Name{CommonName: "billing-worker"},
+		DNSNames: []string{"worker.billing.svc.cluster.local"},
+		URIs:     []*url.URL{{Scheme: "spiffe", Host: "prod.example.com", Path: "/ns/billing/sa/worker"}},
+
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	},
+	"web-frontend": {
+		Subject:  pkix.Name{CommonName: "web-frontend"},
+		DNSNames: []string{"frontend.web.svc.cluster.local"},
+		URIs:     []*url.URL{{Scheme: "spiffe", Host: "prod.example.com", Path: "/ns/web/sa/frontend"}},
+
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	},
+	"ops-cli": {
+		Subject: pkix.Name{CommonName: "ops-cli"},
+
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	},
+}
+
+func (s *WorkloadAccessTestsSuite) SetupSuite() {
+	ca := newTestCA(s.T())
+
+	serverCert := ca.issue(s.T(), &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "bufnet"},
+		DNSNames:    []string{"bufnet"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+
+	s.SetupServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    ca.pool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	})))
+
+	desc.RegisterWorkloadAccessServer(s.server, &services.WorkloadAccessServer{})
+	s.StartServer()
+
+	s.clients = make(map[string]desc.WorkloadAccessClient, len(testWorkloads)+1)
+
+	for name, template := range testWorkloads {
+		s.clients[name] = s.newClient(ca, ca.issue(s.T(), template))
+	}
+
+	s.clients[""] = s.newClient(ca)
+}
+
+func (s *WorkloadAccessTestsSuite) newClient(ca *testCA, certs ...tls.Certificate) desc.WorkloadAccessClient {
+	client, err := s.GetClientConn(grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		Certificates: certs,
+		RootCAs:      ca.pool,
+		ServerName:   "bufnet",
+	})))
+	s.Require().NoError(err, "Failed to dial test server")
+
+	return desc.NewWorkloadAccessClient(client)
+}
+
+func (s *WorkloadAccessTestsSuite) TestWorkloads() {
+	testCases := []struct {
+		name         string
+		workload     string
+		call         func(client desc.WorkloadAccessClient) (*emptypb.Empty, error)
+		expectedCode codes.Code
+	}{
+		{
+			name:     "access allowed for workload with matching spiffe id",
+			workload: "billing-worker",
+			call: func(c desc.WorkloadAccessClient) (*emptypb.Empty, error) {
+				return c.Charge(context.Background(), &emptypb.Empty{})
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:     "access denied for workload with other spiffe id",
+			workload: "web-frontend",
+			call: func(c desc.WorkloadAccessClient) (*emptypb.Empty, error) {
+				return c.Charge(context.Background(), &emptypb.Empty{})
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:     "access denied for workload without spiffe id",
+			workload: "ops-cli",
+			call: func(c desc.WorkloadAccessClient) (*emptypb.Empty, error) {
+				return c.Charge(context.Background(), &emptypb.Empty{})
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:     "access denied without client certificate",
+			workload: "",
+			call: func(c desc.WorkloadAccessClient) (*emptypb.Empty, error) {
+				return c.Charge(context.Background(), &emptypb.Empty{})
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:     "access allowed for workload with matching dns name",
+			workload: "billing-worker",
+			call: func(c desc.WorkloadAccessClient) (*emptypb.Empty, error) {
+				return c.Reconcile(context.Background(), &emptypb.Empty{})
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:     "access allowed for workload with matching common name",
+			workload: "ops-cli",
+			call: func(c desc.WorkloadAccessClient) (*emptypb.Empty, error) {
+				return c.Reconcile(context.Background(), &emptypb.Empty{})
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:     "access denied for workload with other names",
+			workload: "web-frontend",
+			call: func(c desc.WorkloadAccessClient) (*emptypb.Empty, error) {
+				return c.Reconcile(context.Background(), &emptypb.Empty{})
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			_, err := tt.call(s.clients[tt.workload])
+			if tt.expectedCode == codes.OK {
+				s.NoError(err)
+			} else {
+				s.Equal(tt.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+// testCA is a locally generated certificate authority issuing test certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+// issue creates a certificate signed by the CA from the template.
+func (c *testCA) issue(t *testing.T, template *x509.Certificate) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatalf("Failed to generate serial number: %v", err)
+	}
+
+	template.SerialNumber = serialNumber
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+
+	der, err := x509.CreateCertificate(rand.Reader, template, c.cert, &key.PublicKey, c.key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestWorkloadAccessTests(t *testing.T) {
+	suite.Run(t, new(WorkloadAccessTestsSuite))
+}
@@ -0,0 +1,98 @@
+// Package filereload provides values parsed from files and reloaded when the files change.
+package filereload
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCheckInterval is the default minimum time between checks of a file for changes.
+const DefaultCheckInterval = time.Second
+
+// File is a value parsed from a file, safe for concurrent use.
+type File[T any] struct {
+	path     string
+	interval time.Duration
+	parse    func(data []byte) (T, error)
+
+	state     atomic.Pointer[state[T]]
+	nextCheck atomic.Int64 // Unix time in nanoseconds the file is checked for changes after.
+	mu        sync.Mutex   // Held while checking the file.
+}
+
+// state is the outcome of the last load of the file.
+type state[T any] struct {
+	value   T
+	err     error
+	modTime time.Time
+	size    int64
+}
+
+// New loads the file at the path with the parse function.
+// The file is checked for changes at most once per interval. It fails if the file cannot be loaded.
+func New[T any](path string, interval time.Duration, parse func(data []byte) (T, error)) (*File[T], error) {
+	f := &File[T]{path: path, interval: interval, parse: parse}
+
+	f.check(time.Now().UnixNano())
+	if err := f.state.Load().err; err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Load returns the value of the file, reloading it if its modification time or size changed.
+// If the file cannot be read or parsed after a change, Load returns the error until
+// the file is fixed, so a value the file no longer holds is never returned.
+func (f *File[T]) Load() (T, error) {
+	if now := time.Now().UnixNano(); now >= f.nextCheck.Load() && f.mu.TryLock() {
+		f.check(now)
+		f.mu.Unlock()
+	}
+
+	st := f.state.Load()
+	if st.err != nil {
+		var zero T
+		return zero, st.err
+	}
+
+	return st.value, nil
+}
+
+// check reloads the file if it differs from the loaded one. Callers other than New must hold mu.
+func (f *File[T]) check(now int64) {
+	f.nextCheck.Store(now + int64(f.interval))
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		f.fail(err)
+		return
+	}
+
+	if st := f.state.Load(); st != nil && info.ModTime().Equal(st.modTime) && info.Size() == st.size {
+		return
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		f.fail(err)
+		return
+	}
+
+	// A file failing to parse is not reread until it changes.
+	loaded := &state[T]{modTime: info.ModTime(), size: info.Size()}
+	if loaded.value, err = f.parse(data); err != nil {
+		loaded.err = fmt.Errorf("%s: %w", f.path, err)
+	}
+
+	f.state.Store(loaded)
+}
+
+// fail records an error accessing the file, which already names it.
+// The file is reread on the next check.
+func (f *File[T]) fail(err error) {
+	f.state.Store(&state[T]{err: err, size: -1})
+}
@@ -0,0 +1,108 @@
+package filereload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errParse = errors.New("parse error")
+
+func parseInt(data []byte) (int, error) {
+	value, err := strconv.Atoi(string(data))
+	if err != nil {
+		return 0, errParse
+	}
+
+	return value, nil
+}
+
+func Test_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "value")
+	modTime := time.Now().Add(-time.Hour)
+
+	write := func(data string) {
+		modTime = modTime.Add(time.Minute)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	_, err := New(path, 0, parseInt)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	write("x")
+
+	_, err = New(path, 0, parseInt)
+	assert.ErrorIs(t, err, errParse)
+
+	write("1")
+
+	file, err := New(path, 0, parseInt)
+	require.NoError(t, err)
+
+	value, err := file.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	// Changes are picked up.
+	write("2")
+
+	value, err = file.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 2, value)
+
+	// A broken change is reported rather than hidden behind the previous value.
+	write("x")
+
+	_, err = file.Load()
+	assert.ErrorIs(t, err, errParse)
+
+	_, err = file.Load()
+	assert.ErrorIs(t, err, errParse)
+
+	// A fix is picked up.
+	write("3")
+
+	value, err = file.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 3, value)
+
+	// A removed file is reported, and picked up again once restored.
+	require.NoError(t, os.Remove(path))
+
+	_, err = file.Load()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	write("4")
+
+	value, err = file.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 4, value)
+}
+
+func Test_File_checkInterval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "value")
+	require.NoError(t, os.WriteFile(path, []byte("1"), 0o600))
+
+	file, err := New(path, time.Hour, parseInt)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("22"), 0o600))
+
+	// The file is not checked again within the interval.
+	value, err := file.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	file.nextCheck.Store(time.Now().UnixNano())
+
+	value, err = file.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 22, value)
+}
@@ -0,0 +1,38 @@
+// Package jsonvalue converts JSON values decoded with json.Decoder.UseNumber.
+package jsonvalue
+
+import (
+	"encoding/json"
+)
+
+// Plain returns the value with json.Number values, including nested ones,
+// converted to int64 if they are integers in its range, and to float64 otherwise,
+// so that policies, conditions and ownership rules get the number types they expect.
+func Plain(value any) any {
+	switch value := value.(type) {
+	case json.Number:
+		if integer, err := value.Int64(); err == nil {
+			return integer
+		}
+
+		number, _ := value.Float64()
+
+		return number
+	case []any:
+		list := make([]any, len(value))
+		for idx, item := range value {
+			list[idx] = Plain(item)
+		}
+
+		return list
+	case map[string]any:
+		object := make(map[string]any, len(value))
+		for name, item := range value {
+			object[name] = Plain(item)
+		}
+
+		return object
+	default:
+		return value
+	}
+}
@@ -0,0 +1,48 @@
+package jsonvalue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Plain(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  any
+	}{
+		{
+			name:  "integer",
+			value: json.Number("100000000"),
+			want:  int64(100000000),
+		},
+		{
+			name:  "fraction",
+			value: json.Number("1.5"),
+			want:  1.5,
+		},
+		{
+			name:  "integer out of int64 range",
+			value: json.Number("1e20"),
+			want:  1e20,
+		},
+		{
+			name:  "nested numbers",
+			value: map[string]any{"id": json.Number("7"), "limits": []any{json.Number("10"), json.Number("2.5"), "none"}},
+			want:  map[string]any{"id": int64(7), "limits": []any{int64(10), 2.5, "none"}},
+		},
+		{
+			name:  "other value",
+			value: "42",
+			want:  "42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Plain(tt.value))
+		})
+	}
+}
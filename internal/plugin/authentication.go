@@ -0,0 +1,36 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+
+	desc "github.com/casnerano/protoc-gen-go-guard/proto"
+)
+
+// extractAuthenticators translates the accepted authentication schemes, returning nil if they are not declared.
+// It fails on declarations without authenticators, and on empty and duplicate names.
+func extractAuthenticators(pbAuthentication *desc.Authentication) ([]string, error) {
+	if pbAuthentication == nil {
+		return nil, nil
+	}
+
+	names := pbAuthentication.GetAuthenticators()
+	if len(names) == 0 {
+		return nil, errors.New("authentication: no authenticators")
+	}
+
+	seen := make(map[string]struct{}, len(names))
+	for idx, name := range names {
+		if name == "" {
+			return nil, fmt.Errorf("authentication: authenticators[%d]: empty name", idx)
+		}
+
+		if _, exists := seen[name]; exists {
+			return nil, fmt.Errorf("authentication: authenticators[%d]: duplicate name %q", idx, name)
+		}
+
+		seen[name] = struct{}{}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,158 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	desc "github.com/casnerano/protoc-gen-go-guard/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// collectMessages gathers field-level access rules from messages reachable from method requests
+// and responses and returns a map keyed by full message name.
+//
+// Only messages that contain guarded fields, directly or through nested messages, are included,
+// so the interceptor never walks message branches that carry no rules.
+func collectMessages(protoMethods protoreflect.MethodDescriptors, sets ruleSets) (map[string]*guard.Message, error) {
+	var (
+		reachable = make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+		fields    = make(map[protoreflect.FullName]map[string]*guard.Field)
+	)
+
+	for i := 0; i < protoMethods.Len(); i++ {
+		protoMethod := protoMethods.Get(i)
+
+		visited := make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+		walkMessages(protoMethod.Input(), visited)
+		walkMessages(protoMethod.Output(), visited)
+
+		for name, message := range visited {
+			reachable[name] = message
+
+			guardFields, err := extractFields(message, sets)
+			if err != nil {
+				return nil, fmt.Errorf("method %s: message %s: %w", protoMethod.Name(), name, err)
+			}
+
+			for fieldName, field := range guardFields {
+				for _, rules := range []guard.Rules{field.Rules, field.WriteRules} {
+					if err := checkRules(rules, requestMessage(protoMethod)); err != nil {
+						return nil, fmt.Errorf("method %s: field %s.%s: %w", protoMethod.Name(), name, fieldName, err)
+					}
+				}
+			}
+
+			if len(guardFields) > 0 {
+				fields[name] = guardFields
+			}
+		}
+	}
+
+	guarded := make(map[protoreflect.FullName]bool, len(fields))
+	for name := range fields {
+		guarded[name] = true
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for name, message := range reachable {
+			if guarded[name] {
+				continue
+			}
+
+			for _, nested := range nestedMessages(message) {
+				if guarded[nested.FullName()] {
+					guarded[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	if len(guarded) == 0 {
+		return nil, nil
+	}
+
+	messages := make(map[string]*guard.Message, len(guarded))
+	for name := range guarded {
+		messages[string(name)] = &guard.Message{
+			Fields: fields[name],
+		}
+	}
+
+	return messages, nil
+}
+
+// walkMessages collects the message and all messages reachable through its fields.
+func walkMessages(message protoreflect.MessageDescriptor, visited map[protoreflect.FullName]protoreflect.MessageDescriptor) {
+	if _, exists := visited[message.FullName()]; exists {
+		return
+	}
+
+	visited[message.FullName()] = message
+
+	for _, nested := range nestedMessages(message) {
+		walkMessages(nested, visited)
+	}
+}
+
+// nestedMessages returns message types of singular, repeated and map value fields.
+func nestedMessages(message protoreflect.MessageDescriptor) []protoreflect.MessageDescriptor {
+	var nested []protoreflect.MessageDescriptor
+
+	protoFields := message.Fields()
+	for i := 0; i < protoFields.Len(); i++ {
+		protoField := protoFields.Get(i)
+
+		if protoField.IsMap() {
+			protoField = protoField.MapValue()
+		}
+
+		if protoField.Message() != nil {
+			nested = append(nested, protoField.Message())
+		}
+	}
+
+	return nested
+}
+
+// extractFields reads field-level read and write access rules from protobuf field options
+// and returns a map keyed by field name.
+func extractFields(message protoreflect.MessageDescriptor, sets ruleSets) (map[string]*guard.Field, error) {
+	fields := make(map[string]*guard.Field)
+
+	protoFields := message.Fields()
+	for i := 0; i < protoFields.Len(); i++ {
+		protoField := protoFields.Get(i)
+
+		options := protoField.Options()
+		if options == nil {
+			continue
+		}
+
+		var (
+			field guard.Field
+			err   error
+		)
+
+		if pbRules, ok := proto.GetExtension(options, desc.E_FieldRules).([]*desc.Rule); ok && len(pbRules) > 0 {
+			if field.Rules, err = extractRules(pbRules, sets); err != nil {
+				return nil, fmt.Errorf("field %s: %w", protoField.Name(), err)
+			}
+		}
+
+		if pbRules, ok := proto.GetExtension(options, desc.E_FieldWriteRules).([]*desc.Rule); ok && len(pbRules) > 0 {
+			if field.WriteRules, err = extractRules(pbRules, sets); err != nil {
+				return nil, fmt.Errorf("field %s: %w", protoField.Name(), err)
+			}
+		}
+
+		if field.Rules != nil || field.WriteRules != nil {
+			fields[string(protoField.Name())] = &field
+		}
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,50 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	desc "github.com/casnerano/protoc-gen-go-guard/proto"
+)
+
+// extractMetadataMatches translates protobuf metadata matches, lowercasing keys as gRPC does.
+// It fails on keys gRPC does not allow and on values required of absent keys.
+func extractMetadataMatches(pbMatches []*desc.MetadataMatch) ([]*guard.MetadataMatch, error) {
+	matches := make([]*guard.MetadataMatch, 0, len(pbMatches))
+
+	for idx, pbMatch := range pbMatches {
+		key := strings.ToLower(pbMatch.GetKey())
+		if !validMetadataKey(key) {
+			return nil, fmt.Errorf("metadata[%d]: invalid key %q", idx, pbMatch.GetKey())
+		}
+
+		if pbMatch.GetAbsent() && len(pbMatch.GetValues()) > 0 {
+			return nil, fmt.Errorf("metadata[%d]: key %q: values are not allowed with absent", idx, key)
+		}
+
+		matches = append(matches, &guard.MetadataMatch{
+			Key:    key,
+			Values: pbMatch.GetValues(),
+			Absent: pbMatch.GetAbsent(),
+		})
+	}
+
+	return matches, nil
+}
+
+// validMetadataKey reports whether the lowercase key may be sent as gRPC metadata:
+// it consists of digits, lowercase letters, "-", "_" and "." and has no reserved "grpc-" prefix.
+func validMetadataKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "grpc-") {
+		return false
+	}
+
+	for _, r := range key {
+		if !('a' <= r && r <= 'z' || '0' <= r && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,64 @@
+package plugin
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	desc "github.com/casnerano/protoc-gen-go-guard/proto"
+)
+
+// extractNetwork parses the address ranges of the protobuf network, returning nil if it is not set.
+func extractNetwork(pbNetwork *desc.Network) (*guard.Network, error) {
+	if pbNetwork == nil {
+		return nil, nil
+	}
+
+	if len(pbNetwork.GetAllow()) == 0 && len(pbNetwork.GetDeny()) == 0 {
+		return nil, fmt.Errorf("network: no allow or deny ranges")
+	}
+
+	var (
+		network guard.Network
+		err     error
+	)
+
+	if network.Allow, err = parsePrefixes(pbNetwork.GetAllow()); err != nil {
+		return nil, fmt.Errorf("network: allow: %w", err)
+	}
+
+	if network.Deny, err = parsePrefixes(pbNetwork.GetDeny()); err != nil {
+		return nil, fmt.Errorf("network: deny: %w", err)
+	}
+
+	return &network, nil
+}
+
+// parsePrefixes parses CIDR ranges and single addresses, the latter as single-address ranges.
+// Ranges are masked, so "10.8.1.0/16" becomes "10.8.0.0/16".
+func parsePrefixes(values []string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+
+	for _, value := range values {
+		if !strings.Contains(value, "/") {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid address %q", value)
+			}
+
+			addr = addr.Unmap()
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q", value)
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
@@ -0,0 +1,238 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Accessor describes a generated typed getter of a request field for one request message type.
+type Accessor struct {
+	Type    string // Qualified Go type of the request message.
+	Getters string // Getter chain applied to the request, e.g. ".GetUser().GetId()".
+}
+
+// collectOwnershipTargets returns, for every ownership rule of the services,
+// the request messages of the methods the rule applies to.
+// It fails if the ownership request field does not exist on any of them, and on ownership rules
+// of streaming methods, which are authorized before any request message is received.
+func collectOwnershipTargets(
+	protoServices protoreflect.ServiceDescriptors,
+	services []*guard.Service,
+) (map[*guard.Ownership][]protoreflect.MessageDescriptor, error) {
+	targets := make(map[*guard.Ownership][]protoreflect.MessageDescriptor)
+
+	for _, service := range services {
+		protoService := protoServices.ByName(protoreflect.Name(service.Name))
+		if protoService == nil {
+			continue
+		}
+
+		protoMethods := protoService.Methods()
+		for i := 0; i < protoMethods.Len(); i++ {
+			protoMethod := protoMethods.Get(i)
+
+			rules := append(guard.Rules{}, service.DenyRules...)
+
+			method, exists := service.Methods[string(protoMethod.Name())]
+			if exists {
+				rules = append(rules, method.DenyRules...)
+				rules = append(rules, method.Rules...)
+			}
+
+			if !replacesInheritedRules(method) {
+				rules = append(rules, inheritedRules(service)...)
+			}
+
+			rules = append(rules, collectFieldRules(protoMethod, service.Messages)...)
+
+			for _, rule := range rules {
+				if rule.AuthenticatedAccess == nil || rule.AuthenticatedAccess.Ownership == nil {
+					continue
+				}
+
+				ownership := rule.AuthenticatedAccess.Ownership
+				if requestMessage(protoMethod) == nil {
+					return nil, fmt.Errorf("service %s: method %s: ownership: not supported for streaming methods", protoService.Name(), protoMethod.Name())
+				}
+
+				if _, err := resolveFieldPath(protoMethod.Input(), ownership.RequestField); err != nil {
+					return nil, fmt.Errorf("service %s: method %s: ownership: %w", protoService.Name(), protoMethod.Name(), err)
+				}
+
+				if !containsMessage(targets[ownership], protoMethod.Input()) {
+					targets[ownership] = append(targets[ownership], protoMethod.Input())
+				}
+			}
+		}
+	}
+
+	return targets, nil
+}
+
+// collectFieldRules returns field-level rules of all guarded messages reachable from the method.
+func collectFieldRules(protoMethod protoreflect.MethodDescriptor, messages map[string]*guard.Message) guard.Rules {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	visited := make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+	walkMessages(protoMethod.Input(), visited)
+	walkMessages(protoMethod.Output(), visited)
+
+	var rules guard.Rules
+	for name := range visited {
+		if message, exists := messages[string(name)]; exists {
+			for _, field := range message.Fields {
+				rules = append(rules, field.Rules...)
+				rules = append(rules, field.WriteRules...)
+			}
+		}
+	}
+
+	return rules
+}
+
+// resolveFieldPath resolves a dot-separated field path against the message.
+// Every segment but the last must be a singular message field, and the last one must be
+// a singular string or integer field.
+func resolveFieldPath(message protoreflect.MessageDescriptor, fieldPath string) ([]protoreflect.FieldDescriptor, error) {
+	if fieldPath == "" {
+		return nil, fmt.Errorf("empty request field")
+	}
+
+	segments := strings.Split(fieldPath, ".")
+	fields := make([]protoreflect.FieldDescriptor, 0, len(segments))
+
+	for idx, segment := range segments {
+		if message == nil {
+			return nil, fmt.Errorf("field %q: %q is not a message", fieldPath, strings.Join(segments[:idx], "."))
+		}
+
+		field := message.Fields().ByName(protoreflect.Name(segment))
+		if field == nil {
+			return nil, fmt.Errorf("field %q: %s has no field %q", fieldPath, message.FullName(), segment)
+		}
+
+		if field.IsList() || field.IsMap() {
+			return nil, fmt.Errorf("field %q: %q is repeated", fieldPath, segment)
+		}
+
+		fields = append(fields, field)
+		message = field.Message()
+	}
+
+	if message != nil {
+		return nil, fmt.Errorf("field %q: must be a scalar field", fieldPath)
+	}
+
+	if kind := fields[len(fields)-1].Kind(); !ownershipKinds[kind] {
+		return nil, fmt.Errorf("field %q: must be a string or integer field, got %s", fieldPath, kind)
+	}
+
+	return fields, nil
+}
+
+// ownershipKinds are the kinds of request fields ownership rules can compare.
+var ownershipKinds = map[protoreflect.Kind]bool{
+	protoreflect.StringKind:   true,
+	protoreflect.Int32Kind:    true,
+	protoreflect.Sint32Kind:   true,
+	protoreflect.Sfixed32Kind: true,
+	protoreflect.Int64Kind:    true,
+	protoreflect.Sint64Kind:   true,
+	protoreflect.Sfixed64Kind: true,
+	protoreflect.Uint32Kind:   true,
+	protoreflect.Fixed32Kind:  true,
+	protoreflect.Uint64Kind:   true,
+	protoreflect.Fixed64Kind:  true,
+}
+
+// buildAccessors renders typed request accessors for every ownership rule,
+// qualifying request message types relative to the generated file.
+func buildAccessors(
+	g *protogen.GeneratedFile,
+	goMessages map[protoreflect.FullName]*protogen.Message,
+	targets map[*guard.Ownership][]protoreflect.MessageDescriptor,
+) (map[*guard.Ownership][]Accessor, error) {
+	accessors := make(map[*guard.Ownership][]Accessor, len(targets))
+
+	for ownership, inputs := range targets {
+		for _, input := range inputs {
+			goMessage, exists := goMessages[input.FullName()]
+			if !exists {
+				return nil, fmt.Errorf("ownership: unknown request message %s", input.FullName())
+			}
+
+			fields, err := resolveFieldPath(input, ownership.RequestField)
+			if err != nil {
+				return nil, fmt.Errorf("ownership: %w", err)
+			}
+
+			var getters strings.Builder
+			for _, field := range fields {
+				goField := findGoField(goMessage, field)
+				if goField == nil {
+					return nil, fmt.Errorf("ownership: unknown field %s", field.FullName())
+				}
+
+				getters.WriteString(".Get" + goField.GoName + "()")
+				goMessage = goField.Message
+			}
+
+			accessors[ownership] = append(accessors[ownership], Accessor{
+				Type:    g.QualifiedGoIdent(goMessages[input.FullName()].GoIdent),
+				Getters: getters.String(),
+			})
+		}
+	}
+
+	return accessors, nil
+}
+
+// indexGoMessages maps full names of all messages known to the plugin, including nested ones,
+// to their Go representation.
+func indexGoMessages(files []*protogen.File) map[protoreflect.FullName]*protogen.Message {
+	index := make(map[protoreflect.FullName]*protogen.Message)
+
+	var walk func(messages []*protogen.Message)
+	walk = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			index[message.Desc.FullName()] = message
+			walk(message.Messages)
+		}
+	}
+
+	for _, file := range files {
+		walk(file.Messages)
+	}
+
+	return index
+}
+
+func findGoField(message *protogen.Message, field protoreflect.FieldDescriptor) *protogen.Field {
+	if message == nil {
+		return nil
+	}
+
+	for _, goField := range message.Fields {
+		if goField.Desc.Number() == field.Number() {
+			return goField
+		}
+	}
+
+	return nil
+}
+
+func containsMessage(messages []protoreflect.MessageDescriptor, message protoreflect.MessageDescriptor) bool {
+	for _, m := range messages {
+		if m.FullName() == message.FullName() {
+			return true
+		}
+	}
+
+	return false
+}
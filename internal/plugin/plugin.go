@@ -4,7 +4,7 @@
 // For each .proto file containing gRPC services annotated with guard rules,
 // it produces a corresponding .guard.go file that:
 //   - declares a *guard.Service struct containing the access rules
-//     (both service-level and method-level),
+//     (file-level, service-level, method-level and field-level),
 //   - adds a GuardService() method to the Unimplemented<ServiceName>Server
 //     to allow runtime access to these rules.
 package plugin
@@ -13,9 +13,11 @@ import (
 	"embed"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/casnerano/protoc-gen-go-guard/pkg/condition"
 	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
 	desc "github.com/casnerano/protoc-gen-go-guard/proto"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -33,9 +35,11 @@ var templateFS embed.FS
 // TemplateData holds all the information passed to the Go template
 // that generates the .guard.go output file.
 type TemplateData struct {
-	Meta     Meta             // Build and tooling metadata.
-	File     File             // Information about the source .proto file.
-	Services []*guard.Service // Guard rules for all gRPC services in the file.
+	Meta        Meta             // Build and tooling metadata.
+	File        File             // Information about the source .proto file.
+	Services    []*guard.Service // Guard rules for all gRPC services in the file.
+	UsesTime    bool             // Whether any rule has time constraints or a max auth age, so the file imports "time".
+	UsesNetwork bool             // Whether any rule has a network, so the file imports "net/netip".
 }
 
 // Meta contains version information used in the generated file header.
@@ -65,16 +69,54 @@ func Execute(plugin *protogen.Plugin, meta Meta) error {
 		return err
 	}
 
+	goMessages := indexGoMessages(plugin.Files)
+
 	for _, file := range plugin.Files {
 		if !file.Generate {
 			continue
 		}
 
-		services := collectServices(file.Desc.Services())
+		sets, err := collectRuleSets(file.Desc)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+
+		fileRules, err := collectFileRules(file.Desc, sets)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+
+		services, err := collectServices(file.Desc.Services(), fileRules, sets)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+
+		roleHierarchy, err := collectRoleHierarchy(file.Desc)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+
 		if len(services) == 0 {
 			continue
 		}
 
+		for _, service := range services {
+			service.RoleHierarchy = roleHierarchy
+		}
+
+		ownershipTargets, err := collectOwnershipTargets(file.Desc.Services(), services)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+
+		filename := file.GeneratedFilenamePrefix + outputFileSuffix
+		generatedFile := plugin.NewGeneratedFile(filename, file.GoImportPath)
+
+		accessors, err := buildAccessors(generatedFile, goMessages, ownershipTargets)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+
 		templateData := TemplateData{
 			Meta: meta,
 			File: File{
@@ -82,11 +124,16 @@ func Execute(plugin *protogen.Plugin, meta Meta) error {
 				Package: string(file.GoPackageName),
 				Source:  file.Desc.Path(),
 			},
-			Services: services,
+			Services:    services,
+			UsesTime:    anyRule(services, func(rule *guard.Rule) bool { return hasTimeConstraint(rule) || hasMaxAuthAge(rule) }),
+			UsesNetwork: anyRule(services, func(rule *guard.Rule) bool { return rule.Network != nil }),
 		}
 
-		filename := file.GeneratedFilenamePrefix + outputFileSuffix
-		if err = tmpl.Execute(plugin.NewGeneratedFile(filename, file.GoImportPath), templateData); err != nil {
+		tmpl.Funcs(template.FuncMap{
+			"accessors": func(ownership *guard.Ownership) []Accessor { return accessors[ownership] },
+		})
+
+		if err = tmpl.Execute(generatedFile, templateData); err != nil {
 			return fmt.Errorf("failed execute template: %w", err)
 		}
 	}
@@ -94,9 +141,34 @@ func Execute(plugin *protogen.Plugin, meta Meta) error {
 	return nil
 }
 
+// collectFileRules reads the default rules of all services declared in the file.
+func collectFileRules(file protoreflect.FileDescriptor, sets ruleSets) (guard.Rules, error) {
+	options := file.Options()
+	if options == nil {
+		return nil, nil
+	}
+
+	if pbRules, ok := proto.GetExtension(options, desc.E_FileRules).([]*desc.Rule); ok && len(pbRules) > 0 {
+		rules, err := extractRules(pbRules, sets)
+		if err != nil {
+			return nil, fmt.Errorf("file rules: %w", err)
+		}
+
+		return rules, nil
+	}
+
+	return nil, nil
+}
+
 // collectServices converts protobuf service descriptors into internal guard.Service structs,
 // extracting explicitly defined service-level rules and method-level rules.
-func collectServices(protoServices protoreflect.ServiceDescriptors) []*guard.Service {
+// File rules are attached to services that have no service-level rules.
+// References to rule sets are inlined.
+func collectServices(
+	protoServices protoreflect.ServiceDescriptors,
+	fileRules guard.Rules,
+	sets ruleSets,
+) ([]*guard.Service, error) {
 	var services []*guard.Service
 	for i := 0; i < protoServices.Len(); i++ {
 		protoService := protoServices.Get(i)
@@ -105,56 +177,370 @@ func collectServices(protoServices protoreflect.ServiceDescriptors) []*guard.Ser
 			Name: string(protoService.Name()),
 		}
 
+		var err error
+
 		if options := protoService.Options(); options != nil {
 			if pbRules, ok := proto.GetExtension(options, desc.E_ServiceRules).([]*desc.Rule); ok && len(pbRules) > 0 {
-				service.Rules = make([]*guard.Rule, 0, len(pbRules))
-				for _, pbRule := range pbRules {
-					if rules := extractRule(pbRule); rules != nil {
-						service.Rules = append(service.Rules, rules)
-					}
+				if service.Rules, err = extractRules(pbRules, sets); err != nil {
+					return nil, fmt.Errorf("service %s: %w", protoService.Name(), err)
 				}
 			}
+
+			if pbRules, ok := proto.GetExtension(options, desc.E_ServiceDenyRules).([]*desc.Rule); ok && len(pbRules) > 0 {
+				if service.DenyRules, err = extractRules(pbRules, sets); err != nil {
+					return nil, fmt.Errorf("service %s: %w", protoService.Name(), err)
+				}
+			}
+
+			if pbAuthentication, ok := proto.GetExtension(options, desc.E_ServiceAuthentication).(*desc.Authentication); ok {
+				if service.Authenticators, err = extractAuthenticators(pbAuthentication); err != nil {
+					return nil, fmt.Errorf("service %s: %w", protoService.Name(), err)
+				}
+			}
+		}
+
+		if service.Rules == nil {
+			service.FileRules = fileRules
+		}
+
+		methods, err := collectMethods(protoService.Methods(), sets)
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", protoService.Name(), err)
 		}
 
-		if methods := collectMethods(protoService.Methods()); len(methods) > 0 {
+		if len(methods) > 0 {
 			service.Methods = methods
 		}
 
-		if len(service.Rules) == 0 && len(service.Methods) == 0 {
+		if err = checkServiceRules(protoService, &service); err != nil {
+			return nil, fmt.Errorf("service %s: %w", protoService.Name(), err)
+		}
+
+		if service.Messages, err = collectMessages(protoService.Methods(), sets); err != nil {
+			return nil, fmt.Errorf("service %s: %w", protoService.Name(), err)
+		}
+
+		if len(service.Rules) == 0 && len(service.DenyRules) == 0 && len(service.FileRules) == 0 &&
+			len(service.Methods) == 0 && len(service.Messages) == 0 && len(service.Authenticators) == 0 {
 			continue
 		}
 
 		services = append(services, &service)
 	}
 
-	return services
+	return services, nil
 }
 
-// collectMethods gathers method-level access rules from protobuf method options
+// collectMethods gathers method-level access and deny rules, denials and authenticators from protobuf method options
 // and returns a map keyed by method name.
-func collectMethods(protoMethods protoreflect.MethodDescriptors) map[string]*guard.Method {
+func collectMethods(protoMethods protoreflect.MethodDescriptors, sets ruleSets) (map[string]*guard.Method, error) {
 	methods := make(map[string]*guard.Method)
 
 	for i := 0; i < protoMethods.Len(); i++ {
 		protoMethod := protoMethods.Get(i)
 
-		if options := protoMethod.Options(); options != nil {
-			if pbRules, ok := proto.GetExtension(options, desc.E_MethodRules).([]*desc.Rule); ok && len(pbRules) > 0 {
-				guardRules := make([]*guard.Rule, 0, len(pbRules))
-				for _, pbRule := range pbRules {
-					if rules := extractRule(pbRule); rules != nil {
-						guardRules = append(guardRules, rules)
-					}
-				}
+		options := protoMethod.Options()
+		if options == nil {
+			continue
+		}
 
-				methods[string(protoMethod.Name())] = &guard.Method{
-					Rules: guardRules,
-				}
+		var (
+			method guard.Method
+			err    error
+		)
+
+		if pbRules, ok := proto.GetExtension(options, desc.E_MethodRules).([]*desc.Rule); ok && len(pbRules) > 0 {
+			if method.Rules, err = extractRules(pbRules, sets); err != nil {
+				return nil, fmt.Errorf("method %s: %w", protoMethod.Name(), err)
+			}
+		}
+
+		if pbRules, ok := proto.GetExtension(options, desc.E_MethodDenyRules).([]*desc.Rule); ok && len(pbRules) > 0 {
+			if method.DenyRules, err = extractRules(pbRules, sets); err != nil {
+				return nil, fmt.Errorf("method %s: %w", protoMethod.Name(), err)
 			}
 		}
+
+		if inherit, ok := proto.GetExtension(options, desc.E_MethodRulesInherit).(desc.Inherit); ok {
+			method.Inherit = guard.Inherit(inherit)
+		}
+
+		if pbDenial, ok := proto.GetExtension(options, desc.E_MethodDenial).(*desc.Denial); ok {
+			method.Denial = extractDenial(pbDenial)
+		}
+
+		if pbAuthentication, ok := proto.GetExtension(options, desc.E_MethodAuthentication).(*desc.Authentication); ok {
+			if method.Authenticators, err = extractAuthenticators(pbAuthentication); err != nil {
+				return nil, fmt.Errorf("method %s: %w", protoMethod.Name(), err)
+			}
+		}
+
+		if method.Rules == nil && method.Inherit != guard.InheritReplace {
+			return nil, fmt.Errorf("method %s: inherit mode %s requires method rules", protoMethod.Name(), desc.Inherit(method.Inherit))
+		}
+
+		if method.Rules == nil && method.DenyRules == nil && method.Denial == nil && method.Authenticators == nil {
+			continue
+		}
+
+		for _, rules := range []guard.Rules{method.Rules, method.DenyRules} {
+			if err := checkRules(rules, requestMessage(protoMethod)); err != nil {
+				return nil, fmt.Errorf("method %s: %w", protoMethod.Name(), err)
+			}
+		}
+
+		methods[string(protoMethod.Name())] = &method
+	}
+
+	return methods, nil
+}
+
+// checkServiceRules validates service or file rules of the service
+// against the request message of every method that inherits them,
+// i.e. does not replace them with method rules.
+// Service deny rules apply to every method.
+func checkServiceRules(protoService protoreflect.ServiceDescriptor, service *guard.Service) error {
+	protoMethods := protoService.Methods()
+	for i := 0; i < protoMethods.Len(); i++ {
+		protoMethod := protoMethods.Get(i)
+
+		if err := checkRules(service.DenyRules, requestMessage(protoMethod)); err != nil {
+			return fmt.Errorf("method %s: %w", protoMethod.Name(), err)
+		}
+
+		if replacesInheritedRules(service.Methods[string(protoMethod.Name())]) {
+			continue
+		}
+
+		if err := checkRules(inheritedRules(service), requestMessage(protoMethod)); err != nil {
+			return fmt.Errorf("method %s: %w", protoMethod.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// replacesInheritedRules reports whether the method rules replace service or file rules.
+func replacesInheritedRules(method *guard.Method) bool {
+	return method != nil && method.Rules != nil && method.Inherit == guard.InheritReplace
+}
+
+// inheritedRules returns the rules of methods that define no rules of their own:
+// service rules, or file rules when the service has none.
+func inheritedRules(service *guard.Service) guard.Rules {
+	if service.Rules != nil {
+		return service.Rules
+	}
+
+	return service.FileRules
+}
+
+// requestMessage returns the request message rules of the method are evaluated against,
+// or nil for streaming methods: the interceptor authorizes streams before any message is received.
+func requestMessage(protoMethod protoreflect.MethodDescriptor) protoreflect.MessageDescriptor {
+	if protoMethod.IsStreamingClient() || protoMethod.IsStreamingServer() {
+		return nil
 	}
 
-	return methods
+	return protoMethod.Input()
+}
+
+// checkRules validates what the protobuf schema cannot express:
+// requirement thresholds, policy names, time constraints, workload identities and condition expressions.
+func checkRules(rules guard.Rules, input protoreflect.MessageDescriptor) error {
+	if err := checkRequirements(rules); err != nil {
+		return err
+	}
+
+	if err := checkPolicies(rules); err != nil {
+		return err
+	}
+
+	if err := checkTimeConstraints(rules); err != nil {
+		return err
+	}
+
+	if err := checkWorkloads(rules); err != nil {
+		return err
+	}
+
+	return checkConditions(rules, input)
+}
+
+// checkRequirements verifies that AT_LEAST requirements have a reachable threshold
+// and that other requirements have none.
+func checkRequirements(rules guard.Rules) error {
+	for _, rule := range rules {
+		access := rule.AuthenticatedAccess
+		if access == nil {
+			continue
+		}
+
+		if access.RoleBased != nil {
+			if err := checkThreshold(access.RoleBased.Requirement, access.RoleBased.Threshold, len(access.RoleBased.Roles)); err != nil {
+				return fmt.Errorf("role_based: %w", err)
+			}
+		}
+
+		if access.ScopeBased != nil {
+			if err := checkThreshold(access.ScopeBased.Requirement, access.ScopeBased.Threshold, len(access.ScopeBased.Scopes)); err != nil {
+				return fmt.Errorf("scope_based: %w", err)
+			}
+		}
+
+		if access.PolicyBased != nil {
+			if err := checkThreshold(access.PolicyBased.Requirement, access.PolicyBased.Threshold, len(access.PolicyBased.Policies)); err != nil {
+				return fmt.Errorf("policy_based: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkThreshold(requirement guard.Requirement, threshold, count int) error {
+	if requirement != guard.RequirementAtLeast {
+		if threshold != 0 {
+			return fmt.Errorf("threshold is only allowed with AT_LEAST requirement")
+		}
+
+		return nil
+	}
+
+	if threshold < 1 || threshold > count {
+		return fmt.Errorf("threshold %d is out of range [1, %d]", threshold, count)
+	}
+
+	return nil
+}
+
+// checkPolicies verifies that policy-based rules name every policy once, listed or referenced:
+// policies are looked up, counted and given arguments by name.
+func checkPolicies(rules guard.Rules) error {
+	for _, rule := range rules {
+		if rule.AuthenticatedAccess == nil || rule.AuthenticatedAccess.PolicyBased == nil {
+			continue
+		}
+
+		policies := rule.AuthenticatedAccess.PolicyBased.Policies
+
+		seen := make(map[string]struct{}, len(policies))
+		for _, name := range policies {
+			if name == "" {
+				return fmt.Errorf("policy_based: empty policy name")
+			}
+
+			if _, exists := seen[name]; exists {
+				return fmt.Errorf("policy_based: duplicate policy %q", name)
+			}
+
+			seen[name] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// checkConditions type-checks condition rules against the method's request message,
+// so that invalid CEL expressions fail code generation rather than requests at runtime.
+// A nil input, for streaming methods, makes conditions referring to the request invalid.
+func checkConditions(rules guard.Rules, input protoreflect.MessageDescriptor) error {
+	for _, rule := range rules {
+		if rule.Condition == nil {
+			continue
+		}
+
+		env, err := condition.NewEnv(input)
+		if err != nil {
+			return err
+		}
+
+		if _, err = condition.Compile(env, *rule.Condition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractRules translates a list of protobuf-defined Rule messages, skipping empty ones.
+// Rules referencing a rule set are replaced by the rules of the set.
+func extractRules(pbRules []*desc.Rule, sets ruleSets) (guard.Rules, error) {
+	rules := make([]*guard.Rule, 0, len(pbRules))
+	for _, pbRule := range pbRules {
+		if use, ok := pbRule.GetMode().(*desc.Rule_Use); ok {
+			if err := checkUse(pbRule); err != nil {
+				return nil, fmt.Errorf("rule set %q: %w", use.Use, err)
+			}
+
+			setRules, exists := sets[use.Use]
+			if !exists {
+				return nil, fmt.Errorf("unknown rule set %q", use.Use)
+			}
+
+			rules = append(rules, setRules...)
+			continue
+		}
+
+		rule, err := buildRule(pbRule)
+		if err != nil {
+			return nil, err
+		}
+
+		if rule != nil {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, nil
+}
+
+// buildRule translates a protobuf-defined Rule message with its mode, time constraints,
+// denial status, network and metadata matches. Returns nil for rules without a mode.
+func buildRule(pbRule *desc.Rule) (*guard.Rule, error) {
+	rule := extractRule(pbRule)
+	if rule == nil {
+		return nil, nil
+	}
+
+	if rule.AuthenticatedAccess != nil {
+		if err := extractStepUp(pbRule.GetAuthenticatedAccess(), rule.AuthenticatedAccess); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := extractTimeConstraints(pbRule, rule); err != nil {
+		return nil, err
+	}
+
+	rule.Denial = extractDenial(pbRule.GetDenial())
+
+	network, err := extractNetwork(pbRule.GetNetwork())
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Network = network
+
+	if pbMatches := pbRule.GetMetadata(); len(pbMatches) > 0 {
+		if rule.Metadata, err = extractMetadataMatches(pbMatches); err != nil {
+			return nil, err
+		}
+	}
+
+	return rule, nil
+}
+
+// extractDenial translates a protobuf-defined Denial message, returning nil if it is not set.
+func extractDenial(pbDenial *desc.Denial) *guard.Denial {
+	if pbDenial == nil {
+		return nil
+	}
+
+	return &guard.Denial{
+		Code:    uint32(pbDenial.GetCode()),
+		Message: pbDenial.GetMessage(),
+	}
 }
 
 // extractRule translates a protobuf-defined Rule message into the internal guard.Rule representation.
@@ -174,9 +560,25 @@ func extractRule(pbRule *desc.Rule) *guard.Rule {
 			RequireAuthentication: &mode.RequireAuthentication,
 		}
 
+	case *desc.Rule_Condition:
+		return &guard.Rule{
+			Condition: &mode.Condition,
+		}
+
+	case *desc.Rule_Workload:
+		return &guard.Rule{
+			Workload: &guard.Workload{
+				SPIFFEIDs:   mode.Workload.GetSpiffeIds(),
+				CommonNames: mode.Workload.GetCommonNames(),
+				DNSNames:    mode.Workload.GetDnsNames(),
+			},
+		}
+
 	case *desc.Rule_AuthenticatedAccess:
 		if mode.AuthenticatedAccess != nil {
-			authenticatedAccess := &guard.AuthenticatedAccess{}
+			authenticatedAccess := &guard.AuthenticatedAccess{
+				Combine: guard.Combine(mode.AuthenticatedAccess.Combine),
+			}
 
 			if roleBased := mode.AuthenticatedAccess.RoleBased; roleBased != nil {
 				authenticatedAccess.RoleBased = &guard.RoleBased{
@@ -187,6 +589,32 @@ func extractRule(pbRule *desc.Rule) *guard.Rule {
 				if roleBased.Requirement != nil {
 					authenticatedAccess.RoleBased.Requirement = guard.Requirement(*roleBased.Requirement)
 				}
+
+				if roleBased.Threshold != nil {
+					authenticatedAccess.RoleBased.Threshold = int(*roleBased.Threshold)
+				}
+			}
+
+			if scopeBased := mode.AuthenticatedAccess.ScopeBased; scopeBased != nil {
+				authenticatedAccess.ScopeBased = &guard.ScopeBased{
+					Scopes:      scopeBased.Scopes,
+					Requirement: guard.RequirementAtLeastOne,
+				}
+
+				if scopeBased.Requirement != nil {
+					authenticatedAccess.ScopeBased.Requirement = guard.Requirement(*scopeBased.Requirement)
+				}
+
+				if scopeBased.Threshold != nil {
+					authenticatedAccess.ScopeBased.Threshold = int(*scopeBased.Threshold)
+				}
+			}
+
+			if ownership := mode.AuthenticatedAccess.Ownership; ownership != nil {
+				authenticatedAccess.Ownership = &guard.Ownership{
+					RequestField: ownership.RequestField,
+					SubjectAttr:  ownership.SubjectAttr,
+				}
 			}
 
 			if policyBased := mode.AuthenticatedAccess.PolicyBased; policyBased != nil {
@@ -195,9 +623,25 @@ func extractRule(pbRule *desc.Rule) *guard.Rule {
 					Requirement: guard.RequirementAtLeastOne,
 				}
 
+				for _, reference := range policyBased.References {
+					authenticatedAccess.PolicyBased.Policies = append(authenticatedAccess.PolicyBased.Policies, reference.Name)
+
+					if args := extractPolicyArgs(reference.Args); len(args) > 0 {
+						if authenticatedAccess.PolicyBased.Args == nil {
+							authenticatedAccess.PolicyBased.Args = make(map[string]guard.PolicyArgs)
+						}
+
+						authenticatedAccess.PolicyBased.Args[reference.Name] = args
+					}
+				}
+
 				if policyBased.Requirement != nil {
 					authenticatedAccess.PolicyBased.Requirement = guard.Requirement(*policyBased.Requirement)
 				}
+
+				if policyBased.Threshold != nil {
+					authenticatedAccess.PolicyBased.Threshold = int(*policyBased.Threshold)
+				}
 			}
 
 			return &guard.Rule{
@@ -209,6 +653,37 @@ func extractRule(pbRule *desc.Rule) *guard.Rule {
 	return nil
 }
 
+// extractPolicyArgs converts protobuf policy arguments into plain Go values, skipping unset ones.
+func extractPolicyArgs(pbArgs map[string]*desc.PolicyArgument) guard.PolicyArgs {
+	args := make(guard.PolicyArgs, len(pbArgs))
+	for name, pbArg := range pbArgs {
+		switch value := pbArg.GetValue().(type) {
+		case *desc.PolicyArgument_StringValue:
+			args[name] = value.StringValue
+		case *desc.PolicyArgument_NumberValue:
+			args[name] = value.NumberValue
+		case *desc.PolicyArgument_BoolValue:
+			args[name] = value.BoolValue
+		}
+	}
+
+	return args
+}
+
+// literal renders a policy argument value as a Go literal of the same type.
+func literal(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v), nil
+	case float64:
+		return "float64(" + strconv.FormatFloat(v, 'g', -1, 64) + ")", nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", fmt.Errorf("unsupported argument type %T", value)
+	}
+}
+
 // parseTemplate loads and parses the embedded Go template used to generate .guard.go files.
 func parseTemplate() (*template.Template, error) {
 	templateContent, err := templateFS.ReadFile("plugin.go.tmpl")
@@ -219,7 +694,48 @@ func parseTemplate() (*template.Template, error) {
 	tmpl := template.New("plugin.guard").
 		Funcs(template.FuncMap{
 			"toLower": strings.ToLower,
+			"quote":   strconv.Quote,
+			"deref":   func(s *string) string { return *s },
+			"isNil":   func(rules guard.Rules) bool { return rules == nil },
+			"literal": literal,
+			// accessors is rebound for every generated file, see Execute.
+			"accessors": func(*guard.Ownership) []Accessor { return nil },
 		})
 
 	return tmpl.Parse(string(templateContent))
 }
+
+// anyRule reports whether any rule of the services matches.
+func anyRule(services []*guard.Service, match func(rule *guard.Rule) bool) bool {
+	matches := func(rules guard.Rules) bool {
+		for _, rule := range rules {
+			if match(rule) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, service := range services {
+		if matches(service.Rules) || matches(service.DenyRules) || matches(service.FileRules) {
+			return true
+		}
+
+		for _, method := range service.Methods {
+			if matches(method.Rules) || matches(method.DenyRules) {
+				return true
+			}
+		}
+
+		for _, message := range service.Messages {
+			for _, field := range message.Fields {
+				if matches(field.Rules) || matches(field.WriteRules) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -1,14 +1,18 @@
 package plugin
 
 import (
+	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
 	desc "github.com/casnerano/protoc-gen-go-guard/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -214,6 +218,169 @@ func Test_extractRule(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "authenticated access with at least requirement",
+			pbRule: &desc.Rule{
+				Mode: &desc.Rule_AuthenticatedAccess{
+					AuthenticatedAccess: &desc.AuthenticatedAccess{
+						RoleBased: &desc.RoleBased{
+							Roles:       []string{"suspended"},
+							Requirement: desc.Requirement_NONE.Enum(),
+						},
+						PolicyBased: &desc.PolicyBased{
+							Policies:    []string{"approval-1", "approval-2", "approval-3"},
+							Requirement: desc.Requirement_AT_LEAST.Enum(),
+							Threshold:   proto.Uint32(2),
+						},
+					},
+				},
+			},
+			want: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					RoleBased: &guard.RoleBased{
+						Roles:       []string{"suspended"},
+						Requirement: guard.RequirementNone,
+					},
+					PolicyBased: &guard.PolicyBased{
+						Policies:    []string{"approval-1", "approval-2", "approval-3"},
+						Requirement: guard.RequirementAtLeast,
+						Threshold:   2,
+					},
+				},
+			},
+		},
+		{
+			name: "authenticated access with any combine",
+			pbRule: &desc.Rule{
+				Mode: &desc.Rule_AuthenticatedAccess{
+					AuthenticatedAccess: &desc.AuthenticatedAccess{
+						RoleBased: &desc.RoleBased{
+							Roles: []string{"admin"},
+						},
+						PolicyBased: &desc.PolicyBased{
+							Policies: []string{"owner"},
+						},
+						Combine: desc.AuthenticatedAccess_ANY,
+					},
+				},
+			},
+			want: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					RoleBased: &guard.RoleBased{
+						Roles:       []string{"admin"},
+						Requirement: guard.RequirementAtLeastOne,
+					},
+					PolicyBased: &guard.PolicyBased{
+						Policies:    []string{"owner"},
+						Requirement: guard.RequirementAtLeastOne,
+					},
+					Combine: guard.CombineAny,
+				},
+			},
+		},
+		{
+			name: "authenticated access with scope based",
+			pbRule: &desc.Rule{
+				Mode: &desc.Rule_AuthenticatedAccess{
+					AuthenticatedAccess: &desc.AuthenticatedAccess{
+						ScopeBased: &desc.ScopeBased{
+							Scopes:      []string{"Orders:Read"},
+							Requirement: desc.Requirement_ALL.Enum(),
+						},
+					},
+				},
+			},
+			want: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					ScopeBased: &guard.ScopeBased{
+						Scopes:      []string{"Orders:Read"},
+						Requirement: guard.RequirementAll,
+					},
+				},
+			},
+		},
+		{
+			name: "authenticated access with policy references",
+			pbRule: &desc.Rule{
+				Mode: &desc.Rule_AuthenticatedAccess{
+					AuthenticatedAccess: &desc.AuthenticatedAccess{
+						PolicyBased: &desc.PolicyBased{
+							Policies: []string{"premium"},
+							References: []*desc.PolicyReference{
+								{
+									Name: "quota",
+									Args: map[string]*desc.PolicyArgument{
+										"limit":  {Value: &desc.PolicyArgument_NumberValue{NumberValue: 10}},
+										"period": {Value: &desc.PolicyArgument_StringValue{StringValue: "day"}},
+										"strict": {Value: &desc.PolicyArgument_BoolValue{BoolValue: true}},
+										"unset":  {},
+									},
+								},
+								{Name: "verified"},
+							},
+						},
+					},
+				},
+			},
+			want: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					PolicyBased: &guard.PolicyBased{
+						Policies:    []string{"premium", "quota", "verified"},
+						Requirement: guard.RequirementAtLeastOne,
+						Args: map[string]guard.PolicyArgs{
+							"quota": {"limit": float64(10), "period": "day", "strict": true},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "authenticated access with ownership",
+			pbRule: &desc.Rule{
+				Mode: &desc.Rule_AuthenticatedAccess{
+					AuthenticatedAccess: &desc.AuthenticatedAccess{
+						Ownership: &desc.Ownership{
+							RequestField: "user.id",
+							SubjectAttr:  "id",
+						},
+					},
+				},
+			},
+			want: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					Ownership: &guard.Ownership{
+						RequestField: "user.id",
+						SubjectAttr:  "id",
+					},
+				},
+			},
+		},
+		{
+			name: "condition rule",
+			pbRule: &desc.Rule{
+				Mode: &desc.Rule_Condition{Condition: "'admin' in subject.roles"},
+			},
+			want: &guard.Rule{
+				Condition: guard.Ptr("'admin' in subject.roles"),
+			},
+		},
+		{
+			name: "workload rule",
+			pbRule: &desc.Rule{
+				Mode: &desc.Rule_Workload{Workload: &desc.Workload{
+					SpiffeIds:   []string{"spiffe://prod.example.com/ns/billing/**"},
+					CommonNames: []string{"billing-worker"},
+					DnsNames:    []string{"*.billing.svc.cluster.local"},
+				}},
+			},
+			want: &guard.Rule{
+				Workload: &guard.Workload{
+					SPIFFEIDs:   []string{"spiffe://prod.example.com/ns/billing/**"},
+					CommonNames: []string{"billing-worker"},
+					DNSNames:    []string{"*.billing.svc.cluster.local"},
+				},
+			},
+		},
 		{
 			name: "authenticated access with nil authenticated access",
 			pbRule: &desc.Rule{
@@ -235,6 +402,17 @@ func Test_extractRule(t *testing.T) {
 	}
 }
 
+func testConvertGuardRulesToProtoRules(rules guard.Rules) []*desc.Rule {
+	pbRules := make([]*desc.Rule, 0, len(rules))
+	for _, rule := range rules {
+		if pbRule := testConvertGuardRuleToProtoRule(rule); pbRule != nil {
+			pbRules = append(pbRules, pbRule)
+		}
+	}
+
+	return pbRules
+}
+
 func testConvertGuardRuleToProtoRule(rule *guard.Rule) *desc.Rule {
 	if rule == nil {
 		return nil
@@ -248,31 +426,59 @@ func testConvertGuardRuleToProtoRule(rule *guard.Rule) *desc.Rule {
 		return &desc.Rule{Mode: &desc.Rule_RequireAuthentication{RequireAuthentication: *rule.RequireAuthentication}}
 	}
 
+	if rule.Condition != nil {
+		return &desc.Rule{Mode: &desc.Rule_Condition{Condition: *rule.Condition}}
+	}
+
 	if rule.AuthenticatedAccess != nil {
-		authAccess := &desc.AuthenticatedAccess{}
+		authAccess := &desc.AuthenticatedAccess{
+			Combine: desc.AuthenticatedAccess_Combine(rule.AuthenticatedAccess.Combine),
+		}
 
 		if rule.AuthenticatedAccess.RoleBased != nil {
-			req := desc.Requirement_AT_LEAST_ONE
-			if rule.AuthenticatedAccess.RoleBased.Requirement == guard.RequirementAll {
-				req = desc.Requirement_ALL
-			}
+			req := desc.Requirement(rule.AuthenticatedAccess.RoleBased.Requirement)
 
 			authAccess.RoleBased = &desc.RoleBased{
 				Roles:       rule.AuthenticatedAccess.RoleBased.Roles,
 				Requirement: &req,
 			}
+
+			if threshold := rule.AuthenticatedAccess.RoleBased.Threshold; threshold > 0 {
+				authAccess.RoleBased.Threshold = proto.Uint32(uint32(threshold))
+			}
 		}
 
-		if rule.AuthenticatedAccess.PolicyBased != nil {
-			req := desc.Requirement_AT_LEAST_ONE
-			if rule.AuthenticatedAccess.PolicyBased.Requirement == guard.RequirementAll {
-				req = desc.Requirement_ALL
+		if rule.AuthenticatedAccess.ScopeBased != nil {
+			req := desc.Requirement(rule.AuthenticatedAccess.ScopeBased.Requirement)
+
+			authAccess.ScopeBased = &desc.ScopeBased{
+				Scopes:      rule.AuthenticatedAccess.ScopeBased.Scopes,
+				Requirement: &req,
+			}
+
+			if threshold := rule.AuthenticatedAccess.ScopeBased.Threshold; threshold > 0 {
+				authAccess.ScopeBased.Threshold = proto.Uint32(uint32(threshold))
 			}
+		}
+
+		if rule.AuthenticatedAccess.PolicyBased != nil {
+			req := desc.Requirement(rule.AuthenticatedAccess.PolicyBased.Requirement)
 
 			authAccess.PolicyBased = &desc.PolicyBased{
 				Policies:    rule.AuthenticatedAccess.PolicyBased.Policies,
 				Requirement: &req,
 			}
+
+			if threshold := rule.AuthenticatedAccess.PolicyBased.Threshold; threshold > 0 {
+				authAccess.PolicyBased.Threshold = proto.Uint32(uint32(threshold))
+			}
+		}
+
+		if rule.AuthenticatedAccess.Ownership != nil {
+			authAccess.Ownership = &desc.Ownership{
+				RequestField: rule.AuthenticatedAccess.Ownership.RequestField,
+				SubjectAttr:  rule.AuthenticatedAccess.Ownership.SubjectAttr,
+			}
 		}
 
 		return &desc.Rule{Mode: &desc.Rule_AuthenticatedAccess{AuthenticatedAccess: authAccess}}
@@ -297,36 +503,49 @@ func testCreateServiceDescriptors(services []*guard.Service) protoreflect.Servic
 				OutputType: proto.String("test.Empty"),
 			}
 
-			if len(method.Rules) > 0 {
-				pbRules := make([]*desc.Rule, 0, len(method.Rules))
-				for _, rule := range method.Rules {
-					if pbRule := testConvertGuardRuleToProtoRule(rule); pbRule != nil {
-						pbRules = append(pbRules, pbRule)
-					}
-				}
+			opts := &descriptorpb.MethodOptions{}
+			if pbRules := testConvertGuardRulesToProtoRules(method.Rules); len(pbRules) > 0 {
+				proto.SetExtension(opts, desc.E_MethodRules, pbRules)
+				methodProto.Options = opts
+			}
+
+			if pbRules := testConvertGuardRulesToProtoRules(method.DenyRules); len(pbRules) > 0 {
+				proto.SetExtension(opts, desc.E_MethodDenyRules, pbRules)
+				methodProto.Options = opts
+			}
+
+			if method.Inherit != guard.InheritReplace {
+				proto.SetExtension(opts, desc.E_MethodRulesInherit, desc.Inherit(method.Inherit))
+				methodProto.Options = opts
+			}
+
+			if method.Denial != nil {
+				proto.SetExtension(opts, desc.E_MethodDenial, &desc.Denial{
+					Code:    desc.Denial_Code(method.Denial.Code),
+					Message: method.Denial.Message,
+				})
+				methodProto.Options = opts
+			}
 
-				if len(pbRules) > 0 {
-					opts := &descriptorpb.MethodOptions{}
-					proto.SetExtension(opts, desc.E_MethodRules, pbRules)
-					methodProto.Options = opts
-				}
+			if method.Authenticators != nil {
+				proto.SetExtension(opts, desc.E_MethodAuthentication, &desc.Authentication{Authenticators: method.Authenticators})
+				methodProto.Options = opts
 			}
 
 			serviceMethods = append(serviceMethods, methodProto)
 		}
 
 		serviceOptions := &descriptorpb.ServiceOptions{}
-		if len(service.Rules) > 0 {
-			pbRules := make([]*desc.Rule, 0, len(service.Rules))
-			for _, rule := range service.Rules {
-				if pbRule := testConvertGuardRuleToProtoRule(rule); pbRule != nil {
-					pbRules = append(pbRules, pbRule)
-				}
-			}
+		if pbRules := testConvertGuardRulesToProtoRules(service.Rules); len(pbRules) > 0 {
+			proto.SetExtension(serviceOptions, desc.E_ServiceRules, pbRules)
+		}
 
-			if len(pbRules) > 0 {
-				proto.SetExtension(serviceOptions, desc.E_ServiceRules, pbRules)
-			}
+		if pbRules := testConvertGuardRulesToProtoRules(service.DenyRules); len(pbRules) > 0 {
+			proto.SetExtension(serviceOptions, desc.E_ServiceDenyRules, pbRules)
+		}
+
+		if service.Authenticators != nil {
+			proto.SetExtension(serviceOptions, desc.E_ServiceAuthentication, &desc.Authentication{Authenticators: service.Authenticators})
 		}
 
 		serviceProtos = append(serviceProtos, &descriptorpb.ServiceDescriptorProto{
@@ -356,9 +575,10 @@ func Test_collectMethods(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		service *guard.Service
-		want    map[string]*guard.Method
+		name         string
+		service      *guard.Service
+		want         map[string]*guard.Method
+		errAssertion assert.ErrorAssertionFunc
 	}{
 		{
 			name: "empty methods",
@@ -464,6 +684,87 @@ func Test_collectMethods(t *testing.T) {
 			},
 			want: map[string]*guard.Method{},
 		},
+		{
+			name: "method with inherit modes",
+			service: &guard.Service{
+				Name: "Service1",
+				Methods: map[string]*guard.Method{
+					"Method1": {
+						Rules:   guard.Rules{{AllowPublic: guard.Ptr(true)}},
+						Inherit: guard.InheritAppend,
+					},
+					"Method2": {
+						Rules:   guard.Rules{{RequireAuthentication: guard.Ptr(true)}},
+						Inherit: guard.InheritIntersect,
+					},
+				},
+			},
+			want: map[string]*guard.Method{
+				"Method1": {
+					Rules:   guard.Rules{{AllowPublic: guard.Ptr(true)}},
+					Inherit: guard.InheritAppend,
+				},
+				"Method2": {
+					Rules:   guard.Rules{{RequireAuthentication: guard.Ptr(true)}},
+					Inherit: guard.InheritIntersect,
+				},
+			},
+		},
+		{
+			name: "method with denial only",
+			service: &guard.Service{
+				Name: "Service1",
+				Methods: map[string]*guard.Method{
+					"Method1": {
+						Denial: &guard.Denial{Code: uint32(desc.Denial_NOT_FOUND)},
+					},
+				},
+			},
+			want: map[string]*guard.Method{
+				"Method1": {
+					Denial: &guard.Denial{Code: uint32(desc.Denial_NOT_FOUND)},
+				},
+			},
+		},
+		{
+			name: "method with authenticators only",
+			service: &guard.Service{
+				Name: "Service1",
+				Methods: map[string]*guard.Method{
+					"Method1": {Authenticators: []string{"mtls", "jwt"}},
+				},
+			},
+			want: map[string]*guard.Method{
+				"Method1": {Authenticators: []string{"mtls", "jwt"}},
+			},
+		},
+		{
+			name: "method with duplicate authenticators",
+			service: &guard.Service{
+				Name: "Service1",
+				Methods: map[string]*guard.Method{
+					"Method1": {Authenticators: []string{"mtls", "mtls"}},
+				},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `method Method1: authentication: authenticators[1]: duplicate name "mtls"`)
+			},
+		},
+		{
+			name: "inherit mode without method rules",
+			service: &guard.Service{
+				Name: "Service1",
+				Methods: map[string]*guard.Method{
+					"Method1": {
+						DenyRules: guard.Rules{{AllowPublic: guard.Ptr(true)}},
+						Inherit:   guard.InheritAppend,
+					},
+				},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "method Method1: inherit mode APPEND requires method rules")
+			},
+		},
 		{
 			name: "method with authenticated access rule",
 			service: &guard.Service{
@@ -505,7 +806,13 @@ func Test_collectMethods(t *testing.T) {
 			t.Parallel()
 
 			serviceDescs := testCreateServiceDescriptors([]*guard.Service{tt.service})
-			got := collectMethods(serviceDescs.Get(0).Methods())
+			got, err := collectMethods(serviceDescs.Get(0).Methods(), nil)
+			if tt.errAssertion != nil {
+				tt.errAssertion(t, err)
+				return
+			}
+
+			require.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -515,9 +822,10 @@ func Test_collectServices(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name     string
-		services []*guard.Service
-		want     []*guard.Service
+		name      string
+		services  []*guard.Service
+		fileRules guard.Rules
+		want      []*guard.Service
 	}{
 		{
 			name:     "empty services",
@@ -561,6 +869,49 @@ func Test_collectServices(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "service with service and method deny rules",
+			services: []*guard.Service{
+				{
+					Name: "Service1",
+					Rules: guard.Rules{
+						{RequireAuthentication: guard.Ptr(true)},
+					},
+					DenyRules: guard.Rules{
+						{AuthenticatedAccess: &guard.AuthenticatedAccess{
+							RoleBased: &guard.RoleBased{Roles: []string{"suspended"}, Requirement: guard.RequirementAtLeastOne},
+						}},
+					},
+					Methods: map[string]*guard.Method{
+						"Method1": {
+							DenyRules: guard.Rules{
+								{AllowPublic: guard.Ptr(true)},
+							},
+						},
+					},
+				},
+			},
+			want: []*guard.Service{
+				{
+					Name: "Service1",
+					Rules: guard.Rules{
+						{RequireAuthentication: guard.Ptr(true)},
+					},
+					DenyRules: guard.Rules{
+						{AuthenticatedAccess: &guard.AuthenticatedAccess{
+							RoleBased: &guard.RoleBased{Roles: []string{"suspended"}, Requirement: guard.RequirementAtLeastOne},
+						}},
+					},
+					Methods: map[string]*guard.Method{
+						"Method1": {
+							DenyRules: guard.Rules{
+								{AllowPublic: guard.Ptr(true)},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "service with method-level rules only",
 			services: []*guard.Service{
@@ -698,38 +1049,89 @@ func Test_collectServices(t *testing.T) {
 			},
 		},
 		{
-			name: "service with authenticated access rules",
+			name: "file rules attached to services without service rules",
 			services: []*guard.Service{
 				{
 					Name: "Service1",
 					Rules: guard.Rules{
-						{
-							AuthenticatedAccess: &guard.AuthenticatedAccess{
-								RoleBased: &guard.RoleBased{
-									Roles:       []string{"role1", "role2"},
-									Requirement: guard.RequirementAll,
-								},
-							},
-						},
+						{AllowPublic: guard.Ptr(true)},
 					},
 					Methods: map[string]*guard.Method{},
 				},
+				{
+					Name:    "Service2",
+					Methods: map[string]*guard.Method{},
+				},
+			},
+			fileRules: guard.Rules{
+				{RequireAuthentication: guard.Ptr(true)},
 			},
 			want: []*guard.Service{
 				{
 					Name: "Service1",
 					Rules: guard.Rules{
-						{
-							AuthenticatedAccess: &guard.AuthenticatedAccess{
-								RoleBased: &guard.RoleBased{
-									Roles:       []string{"role1", "role2"},
-									Requirement: guard.RequirementAll,
-								},
-							},
-						},
+						{AllowPublic: guard.Ptr(true)},
 					},
 					Methods: nil,
 				},
+				{
+					Name: "Service2",
+					FileRules: guard.Rules{
+						{RequireAuthentication: guard.Ptr(true)},
+					},
+					Methods: nil,
+				},
+			},
+		},
+		{
+			name: "service with authenticated access rules",
+			services: []*guard.Service{
+				{
+					Name: "Service1",
+					Rules: guard.Rules{
+						{
+							AuthenticatedAccess: &guard.AuthenticatedAccess{
+								RoleBased: &guard.RoleBased{
+									Roles:       []string{"role1", "role2"},
+									Requirement: guard.RequirementAll,
+								},
+							},
+						},
+					},
+					Methods: map[string]*guard.Method{},
+				},
+			},
+			want: []*guard.Service{
+				{
+					Name: "Service1",
+					Rules: guard.Rules{
+						{
+							AuthenticatedAccess: &guard.AuthenticatedAccess{
+								RoleBased: &guard.RoleBased{
+									Roles:       []string{"role1", "role2"},
+									Requirement: guard.RequirementAll,
+								},
+							},
+						},
+					},
+					Methods: nil,
+				},
+			},
+		},
+		{
+			name: "service with authenticators only",
+			services: []*guard.Service{
+				{
+					Name:           "Service1",
+					Authenticators: []string{"mtls"},
+					Methods:        map[string]*guard.Method{},
+				},
+			},
+			want: []*guard.Service{
+				{
+					Name:           "Service1",
+					Authenticators: []string{"mtls"},
+				},
 			},
 		},
 	}
@@ -739,8 +1141,1509 @@ func Test_collectServices(t *testing.T) {
 			t.Parallel()
 
 			serviceDescs := testCreateServiceDescriptors(tt.services)
-			got := collectServices(serviceDescs)
+			got, err := collectServices(serviceDescs, tt.fileRules, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_checkPolicies(t *testing.T) {
+	t.Parallel()
+
+	tierReference := func(tier string) *desc.PolicyReference {
+		return &desc.PolicyReference{
+			Name: "tier",
+			Args: map[string]*desc.PolicyArgument{
+				"tier": {Value: &desc.PolicyArgument_StringValue{StringValue: tier}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		pbPolicies   *desc.PolicyBased
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "listed and referenced policies",
+			pbPolicies: &desc.PolicyBased{
+				Policies:   []string{"active"},
+				References: []*desc.PolicyReference{tierReference("gold")},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:       "empty listed policy name",
+			pbPolicies: &desc.PolicyBased{Policies: []string{"active", ""}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "policy_based: empty policy name")
+			},
+		},
+		{
+			name:       "empty referenced policy name",
+			pbPolicies: &desc.PolicyBased{References: []*desc.PolicyReference{{}}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "policy_based: empty policy name")
+			},
+		},
+		{
+			name:       "duplicate listed policy",
+			pbPolicies: &desc.PolicyBased{Policies: []string{"active", "active"}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `policy_based: duplicate policy "active"`)
+			},
+		},
+		{
+			name: "policy listed and referenced",
+			pbPolicies: &desc.PolicyBased{
+				Policies:   []string{"tier"},
+				References: []*desc.PolicyReference{tierReference("gold")},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `policy_based: duplicate policy "tier"`)
+			},
+		},
+		{
+			name: "policy referenced with different arguments",
+			pbPolicies: &desc.PolicyBased{
+				References: []*desc.PolicyReference{tierReference("gold"), tierReference("platinum")},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `policy_based: duplicate policy "tier"`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule := extractRule(&desc.Rule{Mode: &desc.Rule_AuthenticatedAccess{
+				AuthenticatedAccess: &desc.AuthenticatedAccess{PolicyBased: tt.pbPolicies},
+			}})
+
+			tt.errAssertion(t, checkPolicies(guard.Rules{rule}))
+		})
+	}
+}
+
+func Test_checkConditions(t *testing.T) {
+	t.Parallel()
+
+	files := &protoregistry.Files{}
+
+	ownerFd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("owner.proto"),
+		Package: proto.String("common"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Owner"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("id"),
+						JsonName: proto.String("id"),
+						Number:   proto.Int32(1),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					},
+				},
+			},
+		},
+	}, files)
+	require.NoError(t, err)
+	require.NoError(t, files.RegisterFile(ownerFd))
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test.proto"),
+		Package:    proto.String("test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"owner.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("tenant_id"),
+						JsonName: proto.String("tenantId"),
+						Number:   proto.Int32(1),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					},
+					{
+						Name:     proto.String("owner"),
+						JsonName: proto.String("owner"),
+						Number:   proto.Int32(2),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+						TypeName: proto.String(".common.Owner"),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					},
+				},
+			},
+		},
+	}, files)
+	require.NoError(t, err)
+
+	requestDesc := fd.Messages().ByName("Request")
+
+	tests := []struct {
+		name         string
+		rules        guard.Rules
+		streaming    bool
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "rules without conditions",
+			rules:        guard.Rules{{AllowPublic: guard.Ptr(true)}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "valid condition",
+			rules:        guard.Rules{{Condition: guard.Ptr("subject.attrs.tenant_id == request.tenant_id")}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "valid condition with metadata",
+			rules:        guard.Rules{{Condition: guard.Ptr("'x-tenant' in metadata")}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "valid condition with imported message",
+			rules:        guard.Rules{{Condition: guard.Ptr("request.owner.id == 'x'")}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "unknown imported message field",
+			rules:        guard.Rules{{Condition: guard.Ptr("request.owner.unknown_field == 'x'")}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "unknown request field",
+			rules:        guard.Rules{{Condition: guard.Ptr("request.unknown_field == 'value'")}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "syntax error",
+			rules:        guard.Rules{{Condition: guard.Ptr("request.tenant_id ==")}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "non-bool result",
+			rules:        guard.Rules{{Condition: guard.Ptr("request.tenant_id")}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "streaming method condition with metadata",
+			rules:        guard.Rules{{Condition: guard.Ptr("'x-tenant' in metadata")}},
+			streaming:    true,
+			errAssertion: assert.NoError,
+		},
+		{
+			name:      "streaming method condition with request",
+			rules:     guard.Rules{{Condition: guard.Ptr("subject.attrs.tenant_id == request.tenant_id")}},
+			streaming: true,
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorContains(t, err, "undeclared reference to 'request'")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := requestDesc
+			if tt.streaming {
+				input = nil
+			}
+
+			tt.errAssertion(t, checkConditions(tt.rules, input))
+		})
+	}
+}
+
+func testCreateMessagesService(fieldRules, fieldWriteRules []*desc.Rule) protoreflect.ServiceDescriptor {
+	emailOptions := &descriptorpb.FieldOptions{}
+	proto.SetExtension(emailOptions, desc.E_FieldRules, fieldRules)
+	proto.SetExtension(emailOptions, desc.E_FieldWriteRules, fieldWriteRules)
+
+	field := func(name string, number int32, options *descriptorpb.FieldOptions) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Options:  options,
+		}
+	}
+
+	messageField := func(name string, number int32, typeName string, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(typeName),
+			Label:    label.Enum(),
+		}
+	}
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Empty")},
+			{
+				Name: proto.String("Profile"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("email", 1, emailOptions),
+					field("name", 2, nil),
+				},
+			},
+			{
+				Name: proto.String("Wrapper"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					messageField("profiles", 1, ".test.Profile", descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+					messageField("other", 2, ".test.Other", descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+				},
+			},
+			{
+				Name: proto.String("Other"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("value", 1, nil),
+				},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("Service"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{Name: proto.String("Get"), InputType: proto.String(".test.Empty"), OutputType: proto.String(".test.Wrapper")},
+					{Name: proto.String("Plain"), InputType: proto.String(".test.Empty"), OutputType: proto.String(".test.Other")},
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+
+	return fd.Services().Get(0)
+}
+
+func Test_collectMessages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		fieldRules      []*desc.Rule
+		fieldWriteRules []*desc.Rule
+		want            map[string]*guard.Message
+		errAssertion    assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "no field rules",
+			fieldRules:   nil,
+			want:         nil,
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "guarded field in repeated nested message",
+			fieldRules: []*desc.Rule{
+				{Mode: &desc.Rule_RequireAuthentication{RequireAuthentication: true}},
+			},
+			want: map[string]*guard.Message{
+				"test.Wrapper": {},
+				"test.Profile": {
+					Fields: map[string]*guard.Field{
+						"email": {
+							Rules: guard.Rules{{RequireAuthentication: guard.Ptr(true)}},
+						},
+					},
+				},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "guarded field with read and write rules",
+			fieldRules: []*desc.Rule{
+				{Mode: &desc.Rule_AllowPublic{AllowPublic: true}},
+			},
+			fieldWriteRules: []*desc.Rule{
+				{Mode: &desc.Rule_RequireAuthentication{RequireAuthentication: true}},
+			},
+			want: map[string]*guard.Message{
+				"test.Wrapper": {},
+				"test.Profile": {
+					Fields: map[string]*guard.Field{
+						"email": {
+							Rules:      guard.Rules{{AllowPublic: guard.Ptr(true)}},
+							WriteRules: guard.Rules{{RequireAuthentication: guard.Ptr(true)}},
+						},
+					},
+				},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "invalid field write condition",
+			fieldWriteRules: []*desc.Rule{
+				{Mode: &desc.Rule_Condition{Condition: "request.unknown == 'value'"}},
+			},
+			errAssertion: assert.Error,
+		},
+		{
+			name: "invalid field condition",
+			fieldRules: []*desc.Rule{
+				{Mode: &desc.Rule_Condition{Condition: "request.unknown == 'value'"}},
+			},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := testCreateMessagesService(tt.fieldRules, tt.fieldWriteRules)
+
+			got, err := collectMessages(service.Methods(), nil)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func Test_collectOwnershipTargets(t *testing.T) {
+	t.Parallel()
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("user_id"),
+						JsonName: proto.String("userId"),
+						Number:   proto.Int32(1),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					},
+				},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("Service"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{Name: proto.String("Get"), InputType: proto.String(".test.Request"), OutputType: proto.String(".test.Request")},
+					{Name: proto.String("Watch"), InputType: proto.String(".test.Request"), OutputType: proto.String(".test.Request"), ServerStreaming: proto.Bool(true)},
+					{Name: proto.String("Upload"), InputType: proto.String(".test.Request"), OutputType: proto.String(".test.Request"), ClientStreaming: proto.Bool(true)},
+				},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	ownershipRules := func() guard.Rules {
+		return guard.Rules{{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				Ownership: &guard.Ownership{RequestField: "user_id", SubjectAttr: "id"},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "unary method",
+			method:       "Get",
+			errAssertion: assert.NoError,
+		},
+		{
+			name:   "server streaming method",
+			method: "Watch",
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "service Service: method Watch: ownership: not supported for streaming methods")
+			},
+		},
+		{
+			name:   "client streaming method",
+			method: "Upload",
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "service Service: method Upload: ownership: not supported for streaming methods")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules := ownershipRules()
+			services := []*guard.Service{{
+				Name:    "Service",
+				Rules:   guard.Rules{{AllowPublic: guard.Ptr(true)}},
+				Methods: map[string]*guard.Method{tt.method: {Rules: rules}},
+			}}
+
+			targets, err := collectOwnershipTargets(fd.Services(), services)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			ownership := rules[0].AuthenticatedAccess.Ownership
+			assert.Equal(t, []protoreflect.MessageDescriptor{fd.Messages().ByName("Request")}, targets[ownership])
+		})
+	}
+}
+
+func Test_resolveFieldPath(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, number int32, fieldType descriptorpb.FieldDescriptorProto_Type, typeName string, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		fieldDesc := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     fieldType.Enum(),
+			Label:    label.Enum(),
+		}
+
+		if typeName != "" {
+			fieldDesc.TypeName = proto.String(typeName)
+		}
+
+		return fieldDesc
+	}
+
+	var (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	)
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("User"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, "", optional),
+				},
+			},
+			{
+				Name: proto.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("user_id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", optional),
+					field("user", 2, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.User", optional),
+					field("users", 3, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.User", repeated),
+					field("tags", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", repeated),
+					field("shard", 5, descriptorpb.FieldDescriptorProto_TYPE_UINT32, "", optional),
+					field("active", 6, descriptorpb.FieldDescriptorProto_TYPE_BOOL, "", optional),
+					field("score", 7, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, "", optional),
+					field("token", 8, descriptorpb.FieldDescriptorProto_TYPE_BYTES, "", optional),
+				},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	requestDesc := fd.Messages().ByName("Request")
+
+	tests := []struct {
+		name         string
+		fieldPath    string
+		want         []string
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "top-level scalar field",
+			fieldPath:    "user_id",
+			want:         []string{"test.Request.user_id"},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "nested scalar field",
+			fieldPath:    "user.id",
+			want:         []string{"test.Request.user", "test.User.id"},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "unsigned integer field",
+			fieldPath:    "shard",
+			want:         []string{"test.Request.shard"},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "bool field",
+			fieldPath:    "active",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "floating-point field",
+			fieldPath:    "score",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "bytes field",
+			fieldPath:    "token",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "empty path",
+			fieldPath:    "",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "unknown field",
+			fieldPath:    "owner_id",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "message field",
+			fieldPath:    "user",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "path through scalar field",
+			fieldPath:    "user_id.value",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "path through repeated field",
+			fieldPath:    "users.id",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "repeated scalar field",
+			fieldPath:    "tags",
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fields, err := resolveFieldPath(requestDesc, tt.fieldPath)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			got := make([]string, 0, len(fields))
+			for _, field := range fields {
+				got = append(got, string(field.FullName()))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_literal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		value        any
+		want         string
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{name: "string", value: "a \"quoted\" value", want: `"a \"quoted\" value"`, errAssertion: assert.NoError},
+		{name: "integral number", value: float64(10), want: "float64(10)", errAssertion: assert.NoError},
+		{name: "fractional number", value: 0.25, want: "float64(0.25)", errAssertion: assert.NoError},
+		{name: "bool", value: true, want: "true", errAssertion: assert.NoError},
+		{name: "unsupported type", value: 10, errAssertion: assert.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := literal(tt.value)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_collectRoleHierarchy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		inheritances []*desc.RoleInheritance
+		want         guard.RoleHierarchy
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "no role hierarchy",
+			inheritances: nil,
+			want:         nil,
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "linear role hierarchy",
+			inheritances: []*desc.RoleInheritance{
+				{Role: "Admin", Implies: []string{"Editor"}},
+				{Role: "editor", Implies: []string{"viewer"}},
+			},
+			want: guard.RoleHierarchy{
+				"admin":  {"editor"},
+				"editor": {"viewer"},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "repeated role is merged",
+			inheritances: []*desc.RoleInheritance{
+				{Role: "admin", Implies: []string{"editor"}},
+				{Role: "admin", Implies: []string{"billing", "editor"}},
+			},
+			want: guard.RoleHierarchy{
+				"admin": {"editor", "billing"},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "role implies itself",
+			inheritances: []*desc.RoleInheritance{
+				{Role: "admin", Implies: []string{"admin"}},
+			},
+			errAssertion: assert.Error,
+		},
+		{
+			name: "transitive cycle",
+			inheritances: []*desc.RoleInheritance{
+				{Role: "admin", Implies: []string{"editor"}},
+				{Role: "editor", Implies: []string{"viewer"}},
+				{Role: "viewer", Implies: []string{"admin"}},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "role hierarchy: cycle admin -> editor -> viewer -> admin")
+			},
+		},
+		{
+			name: "empty role",
+			inheritances: []*desc.RoleInheritance{
+				{Implies: []string{"viewer"}},
+			},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := &descriptorpb.FileOptions{}
+			if tt.inheritances != nil {
+				proto.SetExtension(options, desc.E_RoleHierarchy, tt.inheritances)
+			}
+
+			fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+				Name:    proto.String("test.proto"),
+				Package: proto.String("test"),
+				Syntax:  proto.String("proto3"),
+				Options: options,
+			}, nil)
+			require.NoError(t, err)
+
+			got, err := collectRoleHierarchy(fd)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_checkRequirements(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		rules        guard.Rules
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "rules without authenticated access",
+			rules:        guard.Rules{{AllowPublic: guard.Ptr(true)}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "at least requirement with valid threshold",
+			rules: guard.Rules{{AuthenticatedAccess: &guard.AuthenticatedAccess{
+				PolicyBased: &guard.PolicyBased{Policies: []string{"p1", "p2", "p3"}, Requirement: guard.RequirementAtLeast, Threshold: 3},
+			}}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "at least requirement without threshold",
+			rules: guard.Rules{{AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{Roles: []string{"admin"}, Requirement: guard.RequirementAtLeast},
+			}}},
+			errAssertion: assert.Error,
+		},
+		{
+			name: "at least requirement with unreachable threshold",
+			rules: guard.Rules{{AuthenticatedAccess: &guard.AuthenticatedAccess{
+				ScopeBased: &guard.ScopeBased{Scopes: []string{"read", "write"}, Requirement: guard.RequirementAtLeast, Threshold: 3},
+			}}},
+			errAssertion: assert.Error,
+		},
+		{
+			name: "threshold with other requirement",
+			rules: guard.Rules{{AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{Roles: []string{"admin", "editor"}, Requirement: guard.RequirementAll, Threshold: 1},
+			}}},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.errAssertion(t, checkRequirements(tt.rules))
+		})
+	}
+}
+
+func Test_extractRules(t *testing.T) {
+	t.Parallel()
+
+	sets := ruleSets{
+		"backoffice": guard.Rules{
+			{AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{Roles: []string{"admin"}, Requirement: guard.RequirementAtLeastOne},
+			}},
+			{Condition: guard.Ptr("'internal' in subject.scopes")},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		pbRules      []*desc.Rule
+		want         guard.Rules
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "rules without rule sets",
+			pbRules: []*desc.Rule{
+				{Mode: &desc.Rule_RequireAuthentication{RequireAuthentication: true}},
+				{},
+			},
+			want:         guard.Rules{{RequireAuthentication: guard.Ptr(true)}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "rule set inlined in place",
+			pbRules: []*desc.Rule{
+				{Mode: &desc.Rule_AllowPublic{AllowPublic: true}},
+				{Mode: &desc.Rule_Use{Use: "backoffice"}},
+			},
+			want: guard.Rules{
+				{AllowPublic: guard.Ptr(true)},
+				sets["backoffice"][0],
+				sets["backoffice"][1],
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "rule with denial and time constraints",
+			pbRules: []*desc.Rule{
+				{
+					Mode:       &desc.Rule_RequireAuthentication{RequireAuthentication: true},
+					ValidUntil: "2025-01-01T00:00:00Z",
+					Denial:     &desc.Denial{Code: desc.Denial_NOT_FOUND, Message: "not found"},
+				},
+			},
+			want: guard.Rules{{
+				RequireAuthentication: guard.Ptr(true),
+				ValidUntil:            guard.Ptr(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				Denial:                &guard.Denial{Code: uint32(desc.Denial_NOT_FOUND), Message: "not found"},
+			}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "rule with step-up requirements",
+			pbRules: []*desc.Rule{
+				{
+					Mode: &desc.Rule_AuthenticatedAccess{AuthenticatedAccess: &desc.AuthenticatedAccess{
+						MinAuthLevel: 2,
+						MaxAuthAge:   "5m",
+					}},
+				},
+			},
+			want: guard.Rules{{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{MinAuthLevel: 2, MaxAuthAge: 5 * time.Minute},
+			}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "rule with metadata",
+			pbRules: []*desc.Rule{
+				{
+					Mode:     &desc.Rule_AllowPublic{AllowPublic: true},
+					Metadata: []*desc.MetadataMatch{{Key: "X-Client-App", Values: []string{"ios"}}},
+				},
+			},
+			want: guard.Rules{{
+				AllowPublic: guard.Ptr(true),
+				Metadata:    []*guard.MetadataMatch{{Key: "x-client-app", Values: []string{"ios"}}},
+			}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "rule set with network",
+			pbRules: []*desc.Rule{
+				{Mode: &desc.Rule_Use{Use: "backoffice"}, Network: &desc.Network{Allow: []string{"10.8.0.0/16"}}},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `rule set "backoffice": network is not allowed with use`)
+			},
+		},
+		{
+			name: "rule set with metadata",
+			pbRules: []*desc.Rule{
+				{Mode: &desc.Rule_Use{Use: "backoffice"}, Metadata: []*desc.MetadataMatch{{Key: "x-request-reason"}}},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `rule set "backoffice": metadata is not allowed with use`)
+			},
+		},
+		{
+			name: "rule set with denial",
+			pbRules: []*desc.Rule{
+				{Mode: &desc.Rule_Use{Use: "backoffice"}, Denial: &desc.Denial{Code: desc.Denial_NOT_FOUND}},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `rule set "backoffice": denial is not allowed with use`)
+			},
+		},
+		{
+			name: "unknown rule set",
+			pbRules: []*desc.Rule{
+				{Mode: &desc.Rule_Use{Use: "support"}},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `unknown rule set "support"`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := extractRules(tt.pbRules, sets)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_collectRuleSets(t *testing.T) {
+	t.Parallel()
+
+	adminRule := &desc.Rule{Mode: &desc.Rule_AuthenticatedAccess{AuthenticatedAccess: &desc.AuthenticatedAccess{
+		RoleBased: &desc.RoleBased{Roles: []string{"admin"}},
+	}}}
+
+	tests := []struct {
+		name         string
+		imported     []*desc.RuleSet
+		declared     []*desc.RuleSet
+		want         ruleSets
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "no rule sets",
+			want:         nil,
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "rule set referencing imported rule set",
+			imported: []*desc.RuleSet{
+				{Name: "staff", Rules: []*desc.Rule{adminRule}},
+			},
+			declared: []*desc.RuleSet{
+				{Name: "backoffice", Rules: []*desc.Rule{
+					{Mode: &desc.Rule_Use{Use: "staff"}},
+					{Mode: &desc.Rule_RequireAuthentication{RequireAuthentication: true}},
+				}},
+			},
+			want: ruleSets{
+				"staff": guard.Rules{extractRule(adminRule)},
+				"backoffice": guard.Rules{
+					extractRule(adminRule),
+					{RequireAuthentication: guard.Ptr(true)},
+				},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "duplicate name across files",
+			imported: []*desc.RuleSet{
+				{Name: "staff", Rules: []*desc.Rule{adminRule}},
+			},
+			declared: []*desc.RuleSet{
+				{Name: "staff", Rules: []*desc.Rule{adminRule}},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `rule set "staff": declared in both base.proto and test.proto`)
+			},
+		},
+		{
+			name: "empty rule set",
+			declared: []*desc.RuleSet{
+				{Name: "staff"},
+			},
+			errAssertion: assert.Error,
+		},
+		{
+			name: "empty name",
+			declared: []*desc.RuleSet{
+				{Rules: []*desc.Rule{adminRule}},
+			},
+			errAssertion: assert.Error,
+		},
+		{
+			name: "unknown reference",
+			declared: []*desc.RuleSet{
+				{Name: "backoffice", Rules: []*desc.Rule{{Mode: &desc.Rule_Use{Use: "staff"}}}},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `rule set "backoffice": unknown rule set "staff"`)
+			},
+		},
+		{
+			name: "reference cycle",
+			imported: []*desc.RuleSet{
+				{Name: "a", Rules: []*desc.Rule{{Mode: &desc.Rule_Use{Use: "b"}}}},
+			},
+			declared: []*desc.RuleSet{
+				{Name: "b", Rules: []*desc.Rule{{Mode: &desc.Rule_Use{Use: "c"}}}},
+				{Name: "c", Rules: []*desc.Rule{adminRule, {Mode: &desc.Rule_Use{Use: "a"}}}},
+			},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "rule set: cycle a -> b -> c -> a")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			importedOptions := &descriptorpb.FileOptions{}
+			if tt.imported != nil {
+				proto.SetExtension(importedOptions, desc.E_RuleSet, tt.imported)
+			}
+
+			base, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+				Name:    proto.String("base.proto"),
+				Package: proto.String("test"),
+				Syntax:  proto.String("proto3"),
+				Options: importedOptions,
+			}, nil)
+			require.NoError(t, err)
+
+			files := new(protoregistry.Files)
+			require.NoError(t, files.RegisterFile(base))
+
+			options := &descriptorpb.FileOptions{}
+			if tt.declared != nil {
+				proto.SetExtension(options, desc.E_RuleSet, tt.declared)
+			}
+
+			fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+				Name:       proto.String("test.proto"),
+				Package:    proto.String("test"),
+				Syntax:     proto.String("proto3"),
+				Dependency: []string{"base.proto"},
+				Options:    options,
+			}, files)
+			require.NoError(t, err)
+
+			got, err := collectRuleSets(fd)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_extractTimeConstraints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		pbRule       *desc.Rule
+		want         *guard.Rule
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "no time constraints",
+			pbRule:       &desc.Rule{},
+			want:         &guard.Rule{},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "validity period",
+			pbRule: &desc.Rule{
+				ValidFrom:  "2025-01-01T00:00:00Z",
+				ValidUntil: "2025-02-01T12:00:00+03:00",
+			},
+			want: &guard.Rule{
+				ValidFrom:  guard.Ptr(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				ValidUntil: guard.Ptr(time.Date(2025, time.February, 1, 12, 0, 0, 0, time.FixedZone("", 3*60*60))),
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "windows with defaults",
+			pbRule: &desc.Rule{
+				Windows: []*desc.TimeWindow{
+					{
+						Weekdays: []desc.Weekday{desc.Weekday_MONDAY, desc.Weekday_SUNDAY},
+						Start:    "09:30",
+						End:      "18:00",
+						TimeZone: "Europe/Berlin",
+					},
+					{},
+				},
+			},
+			want: &guard.Rule{
+				Windows: []*guard.TimeWindow{
+					{
+						Weekdays: []time.Weekday{time.Monday, time.Sunday},
+						Start:    9*time.Hour + 30*time.Minute,
+						End:      18 * time.Hour,
+						TimeZone: "Europe/Berlin",
+					},
+					{End: 24 * time.Hour},
+				},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "invalid valid from",
+			pbRule:       &desc.Rule{ValidFrom: "2025-01-01"},
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "unspecified weekday",
+			pbRule:       &desc.Rule{Windows: []*desc.TimeWindow{{Weekdays: []desc.Weekday{desc.Weekday_WEEKDAY_UNSPECIFIED}}}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:   "invalid time of day",
+			pbRule: &desc.Rule{Windows: []*desc.TimeWindow{{Start: "9:00"}}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `windows[0]: start: time of day "9:00": expected HH:MM`)
+			},
+		},
+		{
+			name:         "time of day out of range",
+			pbRule:       &desc.Rule{Windows: []*desc.TimeWindow{{End: "24:30"}}},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &guard.Rule{}
+			if !tt.errAssertion(t, extractTimeConstraints(tt.pbRule, got)) {
+				return
+			}
+
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_checkTimeConstraints(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		rules        guard.Rules
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "valid time constraints",
+			rules: guard.Rules{{
+				ValidFrom:  guard.Ptr(from),
+				ValidUntil: guard.Ptr(from.Add(time.Hour)),
+				Windows:    []*guard.TimeWindow{{Start: 9 * time.Hour, End: 18 * time.Hour, TimeZone: "Europe/Berlin"}},
+			}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "empty validity period",
+			rules:        guard.Rules{{ValidFrom: guard.Ptr(from), ValidUntil: guard.Ptr(from)}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "overnight window",
+			rules:        guard.Rules{{Windows: []*guard.TimeWindow{{Start: 22 * time.Hour, End: 6 * time.Hour}}}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:  "empty window",
+			rules: guard.Rules{{Windows: []*guard.TimeWindow{{Start: 9 * time.Hour, End: 9 * time.Hour}}}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "windows[0]: start equals end")
+			},
+		},
+		{
+			name:         "unknown time zone",
+			rules:        guard.Rules{{Windows: []*guard.TimeWindow{{End: 24 * time.Hour, TimeZone: "Mars/Olympus_Mons"}}}},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.errAssertion(t, checkTimeConstraints(tt.rules))
+		})
+	}
+}
+
+func Test_extractNetwork(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		pbNetwork    *desc.Network
+		want         *guard.Network
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "no network",
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "ranges and addresses",
+			pbNetwork: &desc.Network{
+				Allow: []string{"10.8.1.0/16", "2001:db8::/32", "192.0.2.10", "::ffff:192.0.2.11"},
+				Deny:  []string{"10.8.255.0/24"},
+			},
+			want: &guard.Network{
+				Allow: []netip.Prefix{
+					netip.MustParsePrefix("10.8.0.0/16"),
+					netip.MustParsePrefix("2001:db8::/32"),
+					netip.MustParsePrefix("192.0.2.10/32"),
+					netip.MustParsePrefix("192.0.2.11/32"),
+				},
+				Deny: []netip.Prefix{netip.MustParsePrefix("10.8.255.0/24")},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "deny only",
+			pbNetwork:    &desc.Network{Deny: []string{"203.0.113.0/24"}},
+			want:         &guard.Network{Deny: []netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")}},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:      "empty network",
+			pbNetwork: &desc.Network{},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "network: no allow or deny ranges")
+			},
+		},
+		{
+			name:      "invalid range",
+			pbNetwork: &desc.Network{Allow: []string{"10.8.0.0/33"}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `network: allow: invalid range "10.8.0.0/33"`)
+			},
+		},
+		{
+			name:      "invalid address",
+			pbNetwork: &desc.Network{Deny: []string{"vpn.example.com"}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `network: deny: invalid address "vpn.example.com"`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := extractNetwork(tt.pbNetwork)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_extractStepUp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		pbAccess     *desc.AuthenticatedAccess
+		want         *guard.AuthenticatedAccess
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "no step-up requirements",
+			pbAccess:     &desc.AuthenticatedAccess{},
+			want:         &guard.AuthenticatedAccess{},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "auth level and age",
+			pbAccess:     &desc.AuthenticatedAccess{MinAuthLevel: 2, MaxAuthAge: "1h30m"},
+			want:         &guard.AuthenticatedAccess{MinAuthLevel: 2, MaxAuthAge: 90 * time.Minute},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:     "invalid age",
+			pbAccess: &desc.AuthenticatedAccess{MaxAuthAge: "5 minutes"},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `max_auth_age: time: unknown unit " minutes" in duration "5 minutes"`)
+			},
+		},
+		{
+			name:     "negative age",
+			pbAccess: &desc.AuthenticatedAccess{MaxAuthAge: "-5m"},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `max_auth_age "-5m": must be a positive whole number of seconds`)
+			},
+		},
+		{
+			name:     "fractional seconds",
+			pbAccess: &desc.AuthenticatedAccess{MaxAuthAge: "1500ms"},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `max_auth_age "1500ms": must be a positive whole number of seconds`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			access := &guard.AuthenticatedAccess{}
+
+			err := extractStepUp(tt.pbAccess, access)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, access)
+		})
+	}
+}
+
+func Test_extractAuthenticators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		pbAuthentication *desc.Authentication
+		want             []string
+		errAssertion     assert.ErrorAssertionFunc
+	}{
+		{
+			name:             "not declared",
+			pbAuthentication: nil,
+			want:             nil,
+			errAssertion:     assert.NoError,
+		},
+		{
+			name:             "authenticators in order",
+			pbAuthentication: &desc.Authentication{Authenticators: []string{"mtls", "jwt"}},
+			want:             []string{"mtls", "jwt"},
+			errAssertion:     assert.NoError,
+		},
+		{
+			name:             "no authenticators",
+			pbAuthentication: &desc.Authentication{},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "authentication: no authenticators")
+			},
+		},
+		{
+			name:             "empty name",
+			pbAuthentication: &desc.Authentication{Authenticators: []string{"jwt", ""}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "authentication: authenticators[1]: empty name")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := extractAuthenticators(tt.pbAuthentication)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_extractMetadataMatches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		pbMatches    []*desc.MetadataMatch
+		want         []*guard.MetadataMatch
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "present, allowed values and absent keys",
+			pbMatches: []*desc.MetadataMatch{
+				{Key: "X-Request-Reason"},
+				{Key: "x-client-app", Values: []string{"ios", "android"}},
+				{Key: "x-debug", Absent: true},
+			},
+			want: []*guard.MetadataMatch{
+				{Key: "x-request-reason"},
+				{Key: "x-client-app", Values: []string{"ios", "android"}},
+				{Key: "x-debug", Absent: true},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:      "empty key",
+			pbMatches: []*desc.MetadataMatch{{Key: ""}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `metadata[0]: invalid key ""`)
+			},
+		},
+		{
+			name:      "invalid key",
+			pbMatches: []*desc.MetadataMatch{{Key: "x-client-app"}, {Key: "x client"}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `metadata[1]: invalid key "x client"`)
+			},
+		},
+		{
+			name:      "reserved key",
+			pbMatches: []*desc.MetadataMatch{{Key: "grpc-timeout"}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `metadata[0]: invalid key "grpc-timeout"`)
+			},
+		},
+		{
+			name:      "absent key with values",
+			pbMatches: []*desc.MetadataMatch{{Key: "X-Debug", Values: []string{"1"}, Absent: true}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `metadata[0]: key "x-debug": values are not allowed with absent`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := extractMetadataMatches(tt.pbMatches)
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_checkWorkloads(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		rules        guard.Rules
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "valid workloads",
+			rules: guard.Rules{
+				{Workload: &guard.Workload{SPIFFEIDs: []string{"spiffe://prod.example.com/ns/billing/**"}}},
+				{Workload: &guard.Workload{CommonNames: []string{"billing-worker"}, DNSNames: []string{"*.billing.svc.cluster.local"}}},
+				{AllowPublic: guard.Ptr(true)},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name:  "workload without identities",
+			rules: guard.Rules{{Workload: &guard.Workload{}}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "workload: no spiffe ids, common names or dns names")
+			},
+		},
+		{
+			name:  "invalid spiffe id pattern",
+			rules: guard.Rules{{Workload: &guard.Workload{SPIFFEIDs: []string{"prod.example.com/ns/billing"}}}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `workload: invalid SPIFFE ID pattern "prod.example.com/ns/billing": must start with "spiffe://"`)
+			},
+		},
+		{
+			name:         "empty common name",
+			rules:        guard.Rules{{Workload: &guard.Workload{CommonNames: []string{""}}}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:  "wildcard inside dns name",
+			rules: guard.Rules{{Workload: &guard.Workload{DNSNames: []string{"api.*.example.com"}}}},
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `workload: invalid dns name "api.*.example.com"`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.errAssertion(t, checkWorkloads(tt.rules))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package plugin
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	desc "github.com/casnerano/protoc-gen-go-guard/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// collectRoleHierarchy reads the role hierarchy from protobuf file options.
+// Role names are lowercased the same way as in role-based rules.
+// It fails if a role implies itself, directly or transitively.
+func collectRoleHierarchy(file protoreflect.FileDescriptor) (guard.RoleHierarchy, error) {
+	options := file.Options()
+	if options == nil {
+		return nil, nil
+	}
+
+	pbInheritances, ok := proto.GetExtension(options, desc.E_RoleHierarchy).([]*desc.RoleInheritance)
+	if !ok || len(pbInheritances) == 0 {
+		return nil, nil
+	}
+
+	hierarchy := make(guard.RoleHierarchy)
+	for _, pbInheritance := range pbInheritances {
+		role := strings.ToLower(pbInheritance.GetRole())
+		if role == "" {
+			return nil, fmt.Errorf("role hierarchy: empty role")
+		}
+
+		for _, implied := range pbInheritance.GetImplies() {
+			implied = strings.ToLower(implied)
+			if implied == "" {
+				return nil, fmt.Errorf("role hierarchy: role %q implies empty role", role)
+			}
+
+			if !slices.Contains(hierarchy[role], implied) {
+				hierarchy[role] = append(hierarchy[role], implied)
+			}
+		}
+	}
+
+	if err := checkRoleCycles(hierarchy); err != nil {
+		return nil, fmt.Errorf("role hierarchy: %w", err)
+	}
+
+	return hierarchy, nil
+}
+
+// checkRoleCycles returns an error naming the roles of the first cycle found in the hierarchy.
+func checkRoleCycles(hierarchy guard.RoleHierarchy) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var (
+		state = make(map[string]int, len(hierarchy))
+		path  []string
+		visit func(role string) error
+	)
+
+	visit = func(role string) error {
+		switch state[role] {
+		case visiting:
+			cycle := append(path[slices.Index(path, role):], role)
+			return fmt.Errorf("cycle %s", strings.Join(cycle, " -> "))
+		case visited:
+			return nil
+		}
+
+		state[role] = visiting
+		path = append(path, role)
+
+		for _, implied := range hierarchy[role] {
+			if err := visit(implied); err != nil {
+				return err
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[role] = visited
+
+		return nil
+	}
+
+	roles := make([]string, 0, len(hierarchy))
+	for role := range hierarchy {
+		roles = append(roles, role)
+	}
+
+	slices.Sort(roles)
+
+	for _, role := range roles {
+		if err := visit(role); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
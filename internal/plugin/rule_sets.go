@@ -0,0 +1,178 @@
+package plugin
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	desc "github.com/casnerano/protoc-gen-go-guard/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ruleSets maps rule set names to their rules, with nested references already inlined.
+type ruleSets map[string]guard.Rules
+
+// collectRuleSets reads rule sets declared in the file and in all files it imports, transitively,
+// and resolves references between them.
+// It fails on duplicate names, empty sets, unknown references and reference cycles.
+func collectRuleSets(file protoreflect.FileDescriptor) (ruleSets, error) {
+	var (
+		declared = make(map[string]*desc.RuleSet)
+		sources  = make(map[string]string)
+		visited  = make(map[string]bool)
+		walk     func(file protoreflect.FileDescriptor) error
+	)
+
+	walk = func(file protoreflect.FileDescriptor) error {
+		if visited[file.Path()] {
+			return nil
+		}
+
+		visited[file.Path()] = true
+
+		imports := file.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			if err := walk(imports.Get(i).FileDescriptor); err != nil {
+				return err
+			}
+		}
+
+		options := file.Options()
+		if options == nil {
+			return nil
+		}
+
+		pbRuleSets, ok := proto.GetExtension(options, desc.E_RuleSet).([]*desc.RuleSet)
+		if !ok {
+			return nil
+		}
+
+		for _, pbRuleSet := range pbRuleSets {
+			name := pbRuleSet.GetName()
+			if name == "" {
+				return fmt.Errorf("rule set: empty name in %s", file.Path())
+			}
+
+			if source, exists := sources[name]; exists {
+				return fmt.Errorf("rule set %q: declared in both %s and %s", name, source, file.Path())
+			}
+
+			if len(pbRuleSet.GetRules()) == 0 {
+				return fmt.Errorf("rule set %q: no rules", name)
+			}
+
+			declared[name] = pbRuleSet
+			sources[name] = file.Path()
+		}
+
+		return nil
+	}
+
+	if err := walk(file); err != nil {
+		return nil, err
+	}
+
+	if len(declared) == 0 {
+		return nil, nil
+	}
+
+	return resolveRuleSets(declared)
+}
+
+// resolveRuleSets inlines references between declared rule sets.
+// It returns an error naming the sets of the first reference cycle found.
+func resolveRuleSets(declared map[string]*desc.RuleSet) (ruleSets, error) {
+	var (
+		sets    = make(ruleSets, len(declared))
+		path    []string
+		resolve func(name string) error
+	)
+
+	resolve = func(name string) error {
+		if _, resolved := sets[name]; resolved {
+			return nil
+		}
+
+		if idx := slices.Index(path, name); idx >= 0 {
+			cycle := append(path[idx:], name)
+			return fmt.Errorf("rule set: cycle %s", strings.Join(cycle, " -> "))
+		}
+
+		path = append(path, name)
+
+		var rules guard.Rules
+		for _, pbRule := range declared[name].GetRules() {
+			use, ok := pbRule.GetMode().(*desc.Rule_Use)
+			if !ok {
+				rule, err := buildRule(pbRule)
+				if err != nil {
+					return fmt.Errorf("rule set %q: %w", name, err)
+				}
+
+				if rule != nil {
+					rules = append(rules, rule)
+				}
+
+				continue
+			}
+
+			if err := checkUse(pbRule); err != nil {
+				return fmt.Errorf("rule set %q: %w", name, err)
+			}
+
+			if _, exists := declared[use.Use]; !exists {
+				return fmt.Errorf("rule set %q: unknown rule set %q", name, use.Use)
+			}
+
+			if err := resolve(use.Use); err != nil {
+				return err
+			}
+
+			rules = append(rules, sets[use.Use]...)
+		}
+
+		path = path[:len(path)-1]
+		sets[name] = rules
+
+		return nil
+	}
+
+	names := make([]string, 0, len(declared))
+	for name := range declared {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		if err := resolve(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return sets, nil
+}
+
+// checkUse rejects options of a rule referencing a rule set: the rule is replaced
+// by the rules of the set, so its options would be silently lost.
+func checkUse(pbRule *desc.Rule) error {
+	if hasTimeConstraints(pbRule) {
+		return fmt.Errorf("time constraints are not allowed with use")
+	}
+
+	if pbRule.GetDenial() != nil {
+		return fmt.Errorf("denial is not allowed with use")
+	}
+
+	if pbRule.GetNetwork() != nil {
+		return fmt.Errorf("network is not allowed with use")
+	}
+
+	if len(pbRule.GetMetadata()) > 0 {
+		return fmt.Errorf("metadata is not allowed with use")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	desc "github.com/casnerano/protoc-gen-go-guard/proto"
+)
+
+// extractStepUp parses the authentication level and freshness required by the protobuf access into the access.
+func extractStepUp(pbAccess *desc.AuthenticatedAccess, access *guard.AuthenticatedAccess) error {
+	access.MinAuthLevel = int(pbAccess.GetMinAuthLevel())
+
+	if maxAuthAge := pbAccess.GetMaxAuthAge(); maxAuthAge != "" {
+		age, err := time.ParseDuration(maxAuthAge)
+		if err != nil {
+			return fmt.Errorf("max_auth_age: %w", err)
+		}
+
+		// Ages are reported to clients in seconds, see interceptor.MetadataMaxAuthAge.
+		if age < time.Second || age%time.Second != 0 {
+			return fmt.Errorf("max_auth_age %q: must be a positive whole number of seconds", maxAuthAge)
+		}
+
+		access.MaxAuthAge = age
+	}
+
+	return nil
+}
+
+// hasMaxAuthAge reports whether the rule requires a fresh authentication.
+func hasMaxAuthAge(rule *guard.Rule) bool {
+	return rule.AuthenticatedAccess != nil && rule.AuthenticatedAccess.MaxAuthAge > 0
+}
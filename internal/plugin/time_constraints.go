@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	desc "github.com/casnerano/protoc-gen-go-guard/proto"
+)
+
+const endOfDay = 24 * time.Hour
+
+// hasTimeConstraints reports whether the protobuf rule restricts when it applies.
+func hasTimeConstraints(pbRule *desc.Rule) bool {
+	return pbRule.GetValidFrom() != "" || pbRule.GetValidUntil() != "" || len(pbRule.GetWindows()) > 0
+}
+
+// extractTimeConstraints parses validity bounds and recurring windows of the protobuf rule into the rule.
+func extractTimeConstraints(pbRule *desc.Rule, rule *guard.Rule) error {
+	if validFrom := pbRule.GetValidFrom(); validFrom != "" {
+		t, err := time.Parse(time.RFC3339, validFrom)
+		if err != nil {
+			return fmt.Errorf("valid_from: %w", err)
+		}
+
+		rule.ValidFrom = &t
+	}
+
+	if validUntil := pbRule.GetValidUntil(); validUntil != "" {
+		t, err := time.Parse(time.RFC3339, validUntil)
+		if err != nil {
+			return fmt.Errorf("valid_until: %w", err)
+		}
+
+		rule.ValidUntil = &t
+	}
+
+	for idx, pbWindow := range pbRule.GetWindows() {
+		window, err := extractTimeWindow(pbWindow)
+		if err != nil {
+			return fmt.Errorf("windows[%d]: %w", idx, err)
+		}
+
+		rule.Windows = append(rule.Windows, window)
+	}
+
+	return nil
+}
+
+func extractTimeWindow(pbWindow *desc.TimeWindow) (*guard.TimeWindow, error) {
+	window := guard.TimeWindow{
+		End:      endOfDay,
+		TimeZone: pbWindow.GetTimeZone(),
+	}
+
+	for _, weekday := range pbWindow.GetWeekdays() {
+		if weekday < desc.Weekday_MONDAY || weekday > desc.Weekday_SUNDAY {
+			return nil, fmt.Errorf("invalid weekday %s", weekday)
+		}
+
+		// Protobuf weekdays start with Monday = 1, Go weekdays with Sunday = 0.
+		window.Weekdays = append(window.Weekdays, time.Weekday(weekday%7))
+	}
+
+	var err error
+
+	if start := pbWindow.GetStart(); start != "" {
+		if window.Start, err = parseTimeOfDay(start); err != nil {
+			return nil, fmt.Errorf("start: %w", err)
+		}
+	}
+
+	if end := pbWindow.GetEnd(); end != "" {
+		if window.End, err = parseTimeOfDay(end); err != nil {
+			return nil, fmt.Errorf("end: %w", err)
+		}
+	}
+
+	return &window, nil
+}
+
+// parseTimeOfDay parses "HH:MM" into an offset from midnight. "24:00" denotes the end of the day.
+func parseTimeOfDay(value string) (time.Duration, error) {
+	hours, minutes, found := strings.Cut(value, ":")
+	if !found || len(hours) != 2 || len(minutes) != 2 {
+		return 0, fmt.Errorf("time of day %q: expected HH:MM", value)
+	}
+
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, fmt.Errorf("time of day %q: expected HH:MM", value)
+	}
+
+	m, err := strconv.Atoi(minutes)
+	if err != nil {
+		return 0, fmt.Errorf("time of day %q: expected HH:MM", value)
+	}
+
+	offset := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+	if h < 0 || m < 0 || m > 59 || offset > endOfDay {
+		return 0, fmt.Errorf("time of day %q: out of range", value)
+	}
+
+	return offset, nil
+}
+
+// checkTimeConstraints rejects time constraints that can never match:
+// empty validity periods, empty windows and windows in unknown time zones.
+// Windows ending before they start wrap past midnight and are valid.
+func checkTimeConstraints(rules guard.Rules) error {
+	for _, rule := range rules {
+		if rule.ValidFrom != nil && rule.ValidUntil != nil && !rule.ValidFrom.Before(*rule.ValidUntil) {
+			return fmt.Errorf("valid_from %s is not before valid_until %s",
+				rule.ValidFrom.Format(time.RFC3339), rule.ValidUntil.Format(time.RFC3339))
+		}
+
+		for idx, window := range rule.Windows {
+			if window.Start == window.End {
+				return fmt.Errorf("windows[%d]: start equals end", idx)
+			}
+
+			if _, err := time.LoadLocation(window.TimeZone); err != nil {
+				return fmt.Errorf("windows[%d]: %w", idx, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasTimeConstraint reports whether the rule restricts when it applies.
+func hasTimeConstraint(rule *guard.Rule) bool {
+	return rule.ValidFrom != nil || rule.ValidUntil != nil || len(rule.Windows) > 0
+}
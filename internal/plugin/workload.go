@@ -0,0 +1,44 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/spiffe"
+)
+
+// checkWorkloads rejects workload rules without identities and with malformed
+// SPIFFE ID patterns or DNS names.
+func checkWorkloads(rules guard.Rules) error {
+	for _, rule := range rules {
+		workload := rule.Workload
+		if workload == nil {
+			continue
+		}
+
+		if len(workload.SPIFFEIDs) == 0 && len(workload.CommonNames) == 0 && len(workload.DNSNames) == 0 {
+			return fmt.Errorf("workload: no spiffe ids, common names or dns names")
+		}
+
+		for _, pattern := range workload.SPIFFEIDs {
+			if err := spiffe.ValidatePattern(pattern); err != nil {
+				return fmt.Errorf("workload: %w", err)
+			}
+		}
+
+		for _, commonName := range workload.CommonNames {
+			if commonName == "" {
+				return fmt.Errorf("workload: empty common name")
+			}
+		}
+
+		for _, dnsName := range workload.DNSNames {
+			if dnsName == "" || strings.Contains(strings.TrimPrefix(dnsName, "*."), "*") {
+				return fmt.Errorf("workload: invalid dns name %q", dnsName)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// Package condition builds the CEL environment used by guard condition rules.
+//
+// The same environment is used by the plugin to type-check expressions
+// at generation time and by the interceptor to evaluate them at runtime,
+// so both sides agree on the available variables:
+//   - subject  — map with "roles" and "scopes" (lists of strings), "attrs" (map of arbitrary values)
+//     and "scheme" (name of the authentication scheme, see interceptor.ChainResolvers);
+//   - request  — the RPC request message, not declared for streaming methods;
+//   - metadata — incoming gRPC metadata as a map of string lists.
+package condition
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	VariableSubject  = "subject"
+	VariableRequest  = "request"
+	VariableMetadata = "metadata"
+)
+
+var ErrInvalidCondition = errors.New("invalid condition")
+
+// NewEnv creates a CEL environment for conditions evaluated against the given request message type.
+// If request is nil, as for streaming methods authorized before any message is received,
+// the request variable is not declared, so expressions referring to it fail to compile.
+func NewEnv(request protoreflect.MessageDescriptor) (*cel.Env, error) {
+	opts := []cel.EnvOption{
+		cel.Variable(VariableSubject, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(VariableMetadata, cel.MapType(cel.StringType, cel.ListType(cel.StringType))),
+	}
+
+	if request != nil {
+		opts = append(opts,
+			cel.TypeDescs(files(request.ParentFile())...),
+			cel.Variable(VariableRequest, cel.ObjectType(string(request.FullName()))),
+		)
+	}
+
+	return cel.NewEnv(opts...)
+}
+
+// files returns the file and its transitive imports, so messages of imported files
+// referred to by the request can be resolved.
+func files(file protoreflect.FileDescriptor) []any {
+	seen := map[string]bool{}
+
+	var descs []any
+	var walk func(file protoreflect.FileDescriptor)
+	walk = func(file protoreflect.FileDescriptor) {
+		if file.IsPlaceholder() || seen[file.Path()] {
+			return
+		}
+
+		seen[file.Path()] = true
+		descs = append(descs, file)
+
+		imports := file.Imports()
+		for i := range imports.Len() {
+			walk(imports.Get(i).FileDescriptor)
+		}
+	}
+
+	walk(file)
+
+	return descs
+}
+
+// Compile parses and type-checks the expression and returns an executable program.
+// The expression must evaluate to a boolean value.
+func Compile(env *cel.Env, expression string) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCondition, issues.Err())
+	}
+
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("%w: expression must return bool, got %s", ErrInvalidCondition, outputType)
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCondition, err)
+	}
+
+	return program, nil
+}
@@ -2,51 +2,214 @@
 // that represent access control rules for gRPC services and methods.
 package guard
 
+import (
+	"net/netip"
+	"time"
+)
+
+// Requirement defines how many of the listed roles, scopes or policies must match.
 type Requirement int
 
 const (
 	RequirementAtLeastOne Requirement = iota
 	RequirementAll
+	RequirementNone    // None of the listed items may match.
+	RequirementAtLeast // At least Threshold of the listed items must match.
 )
 
 // Rule represents a single access control condition.
 // Exactly one of
 //   - AllowPublic — allows unauthenticated access;
 //   - RequireAuthentication — requires authentication but no further checks;
-//   - AuthenticatedAccess — fine-grained role- or policy-based access control.
+//   - AuthenticatedAccess — fine-grained role- or policy-based access control;
+//   - Condition — CEL expression over subject, request and metadata;
+//   - Workload — verified mTLS client certificate of the calling service.
+//
+// ValidFrom, ValidUntil and Windows optionally restrict when the rule applies:
+// outside of them the rule never allows access.
+// Denial optionally overrides the status returned when the rule denies access.
+// Network optionally restricts the rule to client addresses:
+// from other addresses the rule never allows access.
+// Metadata optionally restricts the rule to requests whose incoming metadata
+// matches all of the entries.
 type Rule struct {
 	AllowPublic           *bool
 	RequireAuthentication *bool
 	AuthenticatedAccess   *AuthenticatedAccess
+	Condition             *string
+	Workload              *Workload
+
+	ValidFrom  *time.Time // Inclusive.
+	ValidUntil *time.Time // Exclusive.
+	Windows    []*TimeWindow
+
+	Denial   *Denial
+	Network  *Network
+	Metadata []*MetadataMatch
+}
+
+// MetadataMatch checks a lowercase key of incoming gRPC metadata:
+//   - Absent — the key must not be present;
+//   - Values — the key must have one of the values;
+//   - otherwise the key must be present with any value.
+type MetadataMatch struct {
+	Key    string
+	Values []string
+	Absent bool
+}
+
+// Workload lists identities of calling services, matched against their verified
+// client certificate. The certificate matches if any of the identities matches:
+//   - SPIFFEIDs — SPIFFE ID patterns, see package spiffe;
+//   - CommonNames — subject common names;
+//   - DNSNames — DNS subject alternative names, "*.example.com" matching a single label.
+type Workload struct {
+	SPIFFEIDs   []string
+	CommonNames []string
+	DNSNames    []string
+}
+
+// Network lists client address ranges. An address is allowed if it belongs to
+// none of the Deny ranges and to one of the Allow ranges, or Allow is empty.
+type Network struct {
+	Allow []netip.Prefix
+	Deny  []netip.Prefix
+}
+
+// Denial customizes the gRPC status returned when access is denied.
+// Zero values keep the defaults: Unauthenticated for unauthenticated subjects,
+// PermissionDenied otherwise, and the code name as the message.
+type Denial struct {
+	Code    uint32 // gRPC status code.
+	Message string
+}
+
+// TimeWindow is a recurring period of time, e.g. business hours.
+// Start and End are offsets from midnight in the window time zone.
+// A window ending before it starts wraps past midnight, e.g. 22:00–06:00.
+type TimeWindow struct {
+	Weekdays []time.Weekday // Days the window opens on; every day if empty.
+	Start    time.Duration  // Inclusive.
+	End      time.Duration  // Exclusive.
+	TimeZone string         // IANA time zone name; UTC if empty.
 }
 
 type Rules []*Rule
 
+// Combine defines how the checks of AuthenticatedAccess are combined.
+type Combine int
+
+const (
+	CombineAll Combine = iota // Every configured check must pass.
+	CombineAny                // At least one configured check must pass.
+)
+
 // AuthenticatedAccess defines access conditions for authenticated users,
-// supporting role-based and/or policy-based checks.
+// supporting role-based, scope-based, policy-based and/or ownership checks.
+// MinAuthLevel and MaxAuthAge, if set, require step-up authentication in addition to the checks.
 type AuthenticatedAccess struct {
-	RoleBased   *RoleBased
-	PolicyBased *PolicyBased
+	RoleBased    *RoleBased
+	ScopeBased   *ScopeBased
+	PolicyBased  *PolicyBased
+	Ownership    *Ownership
+	Combine      Combine
+	MinAuthLevel int           // Minimum authentication level of the subject.
+	MaxAuthAge   time.Duration // Maximum time since the subject authenticated.
 }
 
 type RoleBased struct {
 	Roles       []string
 	Requirement Requirement
+	Threshold   int
+}
+
+// ScopeBased lists OAuth2 scopes the client must be granted.
+type ScopeBased struct {
+	Scopes      []string
+	Requirement Requirement
+	Threshold   int
 }
 
+// PolicyBased lists policies to evaluate.
+// Args holds arguments declared for policies in proto, keyed by policy name.
 type PolicyBased struct {
 	Policies    []string
 	Requirement Requirement
+	Threshold   int
+	Args        map[string]PolicyArgs
 }
 
+// PolicyArgs maps argument names to string, float64 or bool values.
+type PolicyArgs map[string]any
+
+// Ownership allows access only when the request field equals the subject attribute.
+// RequestValue is a generated typed accessor that returns the request field value,
+// or false if the request is not one of the expected message types.
+type Ownership struct {
+	RequestField string
+	SubjectAttr  string
+	RequestValue func(request any) (any, bool)
+}
+
+// RoleHierarchy maps a role to the roles it directly implies.
+// Implied roles are expanded transitively, e.g. admin → editor → viewer.
+type RoleHierarchy map[string][]string
+
+// Service holds access rules of a gRPC service.
+// FileRules are the default rules of the file declaring the service,
+// used by methods when neither method nor service rules are defined.
+// DenyRules deny access when any of them matches the subject. They are evaluated before
+// allow rules, and service and method deny rules apply together.
+// Authenticators name the authenticators accepted for methods without their own, in order;
+// the subject resolver of the interceptor is used if neither declares any.
 type Service struct {
-	Name    string
-	Rules   Rules
-	Methods map[string]*Method
+	Name           string
+	Rules          Rules
+	DenyRules      Rules
+	FileRules      Rules
+	Methods        map[string]*Method
+	Messages       map[string]*Message
+	RoleHierarchy  RoleHierarchy
+	Authenticators []string
 }
 
+// Inherit defines how method rules relate to the rules the method would otherwise inherit:
+// service rules, file rules or default rules of the interceptor.
+type Inherit int
+
+const (
+	InheritReplace   Inherit = iota // Method rules replace inherited rules.
+	InheritAppend                   // Either inherited or method rules must allow access.
+	InheritIntersect                // Both inherited and method rules must allow access.
+)
+
+// Method holds access rules of a gRPC method.
+// Inherit applies to Rules only: deny rules are always added to the service deny rules.
+// Denial applies to denials by rules that declare no denial of their own.
+// Authenticators replace the service authenticators.
 type Method struct {
-	Rules Rules
+	Rules          Rules
+	DenyRules      Rules
+	Inherit        Inherit
+	Denial         *Denial
+	Authenticators []string
+}
+
+// Message holds field-level access rules of a protobuf message, keyed by field name.
+// A message without guarded fields of its own is still listed when it leads to
+// nested messages that have them.
+type Message struct {
+	Fields map[string]*Field
+}
+
+// Field holds field-level access rules:
+//   - Rules decide whether the subject may see the field value in responses;
+//   - WriteRules decide whether the subject may set the field in requests.
+//
+// A nil list means the field is not guarded in that direction.
+type Field struct {
+	Rules      Rules
+	WriteRules Rules
 }
 
 func Ptr[T any](v T) *T {
@@ -0,0 +1,120 @@
+// Package apikeyresolver provides an interceptor.SubjectResolver authenticating machine clients
+// by API keys sent in request metadata.
+//
+// Keys are never stored in clear: a KeyStore looks keys up by their SHA-256 digest, and the digest
+// of the found key is compared with the presented one in constant time. Keys carry the roles,
+// scopes and attributes of the subject they authenticate, an optional expiry and a revocation flag.
+//
+// Requests without a key resolve to a nil subject, so rules treat them as unauthenticated.
+// Unknown, expired and revoked keys are rejected with errors wrapping
+// interceptor.ErrInvalidCredentials and ErrInvalidKey.
+package apikeyresolver
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// DefaultHeader is the default metadata key carrying the API key.
+	DefaultHeader = "x-api-key"
+	// AttrKeyID is the subject attribute holding the ID of the authenticating key.
+	AttrKeyID = "api_key_id"
+)
+
+var ErrInvalidKey = errors.New("invalid API key")
+
+// Key is an API key known to a KeyStore.
+type Key struct {
+	ID        string         // Non-secret identifier of the key, e.g. the name of the client.
+	Hash      [32]byte       // SHA-256 digest of the key, see Hash.
+	Roles     []string       // Roles of the subject authenticated by the key.
+	Scopes    []string       // OAuth2 scopes granted to the client.
+	Attrs     map[string]any // Attributes of the subject authenticated by the key.
+	ExpiresAt time.Time      // Time the key expires at (zero if it never expires).
+	Revoked   bool           // Whether the key is revoked.
+}
+
+// KeyStore looks up API keys.
+type KeyStore interface {
+	// Lookup returns the key with the SHA-256 digest, or nil if there is none.
+	Lookup(ctx context.Context, hash [32]byte) (*Key, error)
+}
+
+// Hash returns the SHA-256 digest API keys are stored and looked up by.
+func Hash(key string) [32]byte {
+	return sha256.Sum256([]byte(key))
+}
+
+type resolver struct {
+	store  KeyStore
+	header string
+	clock  func() time.Time
+}
+
+// New returns a SubjectResolver looking up the API key of the request in the store.
+// The subject gets the roles, scopes and attributes of the key, and the key ID as the AttrKeyID attribute.
+func New(store KeyStore, opts ...Option) interceptor.SubjectResolver {
+	r := &resolver{
+		store:  store,
+		header: DefaultHeader,
+		clock:  time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r.resolve
+}
+
+func (r *resolver) resolve(ctx context.Context) (*interceptor.Subject, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(r.header)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	if values[0] == "" {
+		return nil, invalidKey("empty key")
+	}
+
+	hash := Hash(values[0])
+
+	key, err := r.store.Lookup(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("key store: %w", err)
+	}
+
+	switch {
+	case key == nil || subtle.ConstantTimeCompare(key.Hash[:], hash[:]) != 1:
+		return nil, invalidKey("unknown key")
+	case key.Revoked:
+		return nil, invalidKey("key %q revoked", key.ID)
+	case !key.ExpiresAt.IsZero() && !r.clock().Before(key.ExpiresAt):
+		return nil, invalidKey("key %q expired", key.ID)
+	}
+
+	attrs := make(map[string]any, len(key.Attrs)+1)
+	maps.Copy(attrs, key.Attrs)
+	attrs[AttrKeyID] = key.ID
+
+	return &interceptor.Subject{
+		Roles:  key.Roles,
+		Scopes: key.Scopes,
+		Attrs:  attrs,
+	}, nil
+}
+
+func invalidKey(format string, args ...any) error {
+	return fmt.Errorf("%w: %w: %s", interceptor.ErrInvalidCredentials, ErrInvalidKey, fmt.Sprintf(format, args...))
+}
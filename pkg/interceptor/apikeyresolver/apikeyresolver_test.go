@@ -0,0 +1,134 @@
+package apikeyresolver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+var testNow = time.Date(2025, time.March, 5, 10, 30, 0, 0, time.UTC)
+
+func contextWithMetadata(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+func Test_resolver(t *testing.T) {
+	store := NewMemoryStore(
+		&Key{
+			ID:     "billing-worker",
+			Hash:   Hash("billing-secret"),
+			Roles:  []string{"billing"},
+			Scopes: []string{"invoices:write"},
+			Attrs:  map[string]any{"team": "payments"},
+		},
+		&Key{ID: "expiring", Hash: Hash("expiring-secret"), ExpiresAt: testNow.Add(time.Hour)},
+		&Key{ID: "expired", Hash: Hash("expired-secret"), ExpiresAt: testNow},
+		&Key{ID: "revoked", Hash: Hash("revoked-secret"), Revoked: true},
+	)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		opts    []Option
+		want    *interceptor.Subject
+		wantErr bool
+	}{
+		{
+			name: "no metadata",
+			ctx:  context.Background(),
+			want: nil,
+		},
+		{
+			name: "no key",
+			ctx:  contextWithMetadata("authorization", "Bearer token"),
+			want: nil,
+		},
+		{
+			name: "known key",
+			ctx:  contextWithMetadata("x-api-key", "billing-secret"),
+			want: &interceptor.Subject{
+				Roles:  []string{"billing"},
+				Scopes: []string{"invoices:write"},
+				Attrs:  map[string]any{"team": "payments", AttrKeyID: "billing-worker"},
+			},
+		},
+		{
+			name: "key in custom header",
+			ctx:  contextWithMetadata("x-client-key", "expiring-secret"),
+			opts: []Option{WithHeader("X-Client-Key")},
+			want: &interceptor.Subject{Attrs: map[string]any{AttrKeyID: "expiring"}},
+		},
+		{
+			name:    "empty key",
+			ctx:     contextWithMetadata("x-api-key", ""),
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			ctx:     contextWithMetadata("x-api-key", "guessed-secret"),
+			wantErr: true,
+		},
+		{
+			name:    "expired key",
+			ctx:     contextWithMetadata("x-api-key", "expired-secret"),
+			wantErr: true,
+		},
+		{
+			name:    "revoked key",
+			ctx:     contextWithMetadata("x-api-key", "revoked-secret"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolve := New(store, append([]Option{WithClock(func() time.Time { return testNow })}, tt.opts...)...)
+
+			subject, err := resolve(tt.ctx)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, interceptor.ErrInvalidCredentials)
+				assert.ErrorIs(t, err, ErrInvalidKey)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, subject)
+		})
+	}
+}
+
+func Test_resolver_storeError(t *testing.T) {
+	resolve := New(failingStore{})
+
+	_, err := resolve(contextWithMetadata("x-api-key", "billing-secret"))
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, interceptor.ErrInvalidCredentials)
+}
+
+func Test_resolver_hashMismatch(t *testing.T) {
+	// A store must not return a key of another digest; the resolver does not trust it to.
+	resolve := New(mismatchingStore{key: &Key{ID: "other", Hash: Hash("other-secret")}})
+
+	_, err := resolve(contextWithMetadata("x-api-key", "billing-secret"))
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+type failingStore struct{}
+
+func (failingStore) Lookup(context.Context, [32]byte) (*Key, error) {
+	return nil, errors.New("database unavailable")
+}
+
+type mismatchingStore struct {
+	key *Key
+}
+
+func (s mismatchingStore) Lookup(context.Context, [32]byte) (*Key, error) {
+	return s.key, nil
+}
@@ -0,0 +1,45 @@
+package apikeyresolver
+
+import (
+	"time"
+)
+
+// Option configures the resolver.
+type Option func(r *resolver)
+
+// WithHeader sets the metadata key carrying the API key, matched case-insensitively.
+// Defaults to DefaultHeader.
+func WithHeader(header string) Option {
+	return func(r *resolver) {
+		if header != "" {
+			r.header = header
+		}
+	}
+}
+
+// WithClock sets the source of the current time used to check key expiry.
+// Defaults to time.Now.
+func WithClock(clock func() time.Time) Option {
+	return func(r *resolver) {
+		if clock != nil {
+			r.clock = clock
+		}
+	}
+}
+
+// FileOption configures a store read from a file.
+type FileOption func(o *fileOptions)
+
+type fileOptions struct {
+	checkInterval time.Duration
+}
+
+// WithCheckInterval sets the minimum time between checks of the file for changes,
+// zero checking it on every lookup. Defaults to DefaultCheckInterval.
+func WithCheckInterval(interval time.Duration) FileOption {
+	return func(o *fileOptions) {
+		if interval >= 0 {
+			o.checkInterval = interval
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package apikeyresolver
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/internal/filereload"
+	"github.com/casnerano/protoc-gen-go-guard/internal/jsonvalue"
+)
+
+// DefaultCheckInterval is the default minimum time between checks of a key file for changes.
+const DefaultCheckInterval = filereload.DefaultCheckInterval
+
+var ErrInvalidKeyFile = errors.New("invalid API key file")
+
+// MemoryStore is a KeyStore of keys held in memory, safe for concurrent use.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	keys map[[32]byte]*Key
+}
+
+// NewMemoryStore returns a store of the keys.
+func NewMemoryStore(keys ...*Key) *MemoryStore {
+	s := &MemoryStore{keys: make(map[[32]byte]*Key, len(keys))}
+	for _, key := range keys {
+		s.Add(key)
+	}
+
+	return s
+}
+
+// Add adds the key, replacing a key with the same hash.
+func (s *MemoryStore) Add(key *Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key.Hash] = key
+}
+
+// Revoke revokes all keys with the ID. Returns false if there are none.
+func (s *MemoryStore) Revoke(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var revoked bool
+	for hash, key := range s.keys {
+		if key.ID == id {
+			revokedKey := *key
+			revokedKey.Revoked = true
+			s.keys[hash] = &revokedKey
+			revoked = true
+		}
+	}
+
+	return revoked
+}
+
+func (s *MemoryStore) Lookup(_ context.Context, hash [32]byte) (*Key, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.keys[hash], nil
+}
+
+// fileStore is a key file reloaded when it changes.
+type fileStore struct {
+	file *filereload.File[map[[32]byte]*Key]
+}
+
+// fileKey is an entry of the key file.
+type fileKey struct {
+	ID        string         `json:"id"`
+	SHA256    string         `json:"sha256"`
+	Roles     []string       `json:"roles"`
+	Scopes    []string       `json:"scopes"`
+	Attrs     map[string]any `json:"attrs"`
+	ExpiresAt string         `json:"expires_at"`
+	Revoked   bool           `json:"revoked"`
+}
+
+// FileStore returns a store of hashed keys read from the JSON file at the path:
+//
+//	{"keys": [{
+//	  "id": "billing-worker",
+//	  "sha256": "<hex-encoded SHA-256 digest of the key>",
+//	  "roles": ["billing"],
+//	  "scopes": ["invoices:write"],
+//	  "attrs": {"team": "payments"},
+//	  "expires_at": "2026-01-01T00:00:00Z",
+//	  "revoked": false
+//	}]}
+//
+// Integer attributes become int64 and other numbers float64, as JWT claims do in jwtresolver.
+// The file is checked for changes at most once per check interval and reloaded if it changed,
+// so keys can be added, revoked and removed without a restart. If the file cannot be read or parsed
+// after a change, lookups fail until the file is fixed, so a key revoked by a broken edit
+// is never accepted. It fails if the file cannot be loaded initially.
+func FileStore(path string, opts ...FileOption) (KeyStore, error) {
+	options := fileOptions{checkInterval: DefaultCheckInterval}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	file, err := filereload.New(path, options.checkInterval, parseKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("key file: %w", err)
+	}
+
+	return &fileStore{file: file}, nil
+}
+
+func (f *fileStore) Lookup(_ context.Context, hash [32]byte) (*Key, error) {
+	keys, err := f.file.Load()
+	if err != nil {
+		return nil, fmt.Errorf("key file: %w", err)
+	}
+
+	return keys[hash], nil
+}
+
+// parseKeyFile parses the key file, see FileStore. Numbers in attributes become int64 or float64,
+// see jsonvalue.Plain. It fails on missing IDs, malformed digests and expiry times, and duplicate digests.
+func parseKeyFile(data []byte) (map[[32]byte]*Key, error) {
+	var file struct {
+		Keys []fileKey `json:"keys"`
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeyFile, err)
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: data after the top-level value", ErrInvalidKeyFile)
+	}
+
+	keys := make(map[[32]byte]*Key, len(file.Keys))
+	for idx, entry := range file.Keys {
+		if entry.ID == "" {
+			return nil, fmt.Errorf("%w: keys[%d]: empty id", ErrInvalidKeyFile, idx)
+		}
+
+		key := &Key{
+			ID:      entry.ID,
+			Roles:   entry.Roles,
+			Scopes:  entry.Scopes,
+			Attrs:   plainAttrs(entry.Attrs),
+			Revoked: entry.Revoked,
+		}
+
+		digest, err := hex.DecodeString(entry.SHA256)
+		if err != nil || len(digest) != len(key.Hash) {
+			return nil, fmt.Errorf("%w: key %q: sha256 must be %d hex digits", ErrInvalidKeyFile, entry.ID, hex.EncodedLen(len(key.Hash)))
+		}
+
+		copy(key.Hash[:], digest)
+
+		if entry.ExpiresAt != "" {
+			expiresAt, err := time.Parse(time.RFC3339, entry.ExpiresAt)
+			if err != nil {
+				return nil, fmt.Errorf("%w: key %q: expires_at: %w", ErrInvalidKeyFile, entry.ID, err)
+			}
+
+			key.ExpiresAt = expiresAt
+		}
+
+		if _, exists := keys[key.Hash]; exists {
+			return nil, fmt.Errorf("%w: key %q: duplicate sha256", ErrInvalidKeyFile, entry.ID)
+		}
+
+		keys[key.Hash] = key
+	}
+
+	return keys, nil
+}
+
+// plainAttrs converts numbers in attributes decoded as json.Number.
+func plainAttrs(attrs map[string]any) map[string]any {
+	if attrs == nil {
+		return nil
+	}
+
+	return jsonvalue.Plain(attrs).(map[string]any)
+}
@@ -0,0 +1,198 @@
+package apikeyresolver
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MemoryStore(t *testing.T) {
+	store := NewMemoryStore(&Key{ID: "billing-worker", Hash: Hash("billing-secret")})
+
+	key, err := store.Lookup(context.Background(), Hash("billing-secret"))
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.False(t, key.Revoked)
+
+	assert.True(t, store.Revoke("billing-worker"))
+	assert.False(t, store.Revoke("unknown"))
+
+	revoked, err := store.Lookup(context.Background(), Hash("billing-secret"))
+	require.NoError(t, err)
+	assert.True(t, revoked.Revoked)
+	assert.False(t, key.Revoked, "previously returned key is not modified")
+
+	store.Add(&Key{ID: "reporting", Hash: Hash("reporting-secret")})
+
+	added, err := store.Lookup(context.Background(), Hash("reporting-secret"))
+	require.NoError(t, err)
+	assert.Equal(t, "reporting", added.ID)
+
+	unknown, err := store.Lookup(context.Background(), Hash("unknown-secret"))
+	require.NoError(t, err)
+	assert.Nil(t, unknown)
+}
+
+func Test_parseKeyFile(t *testing.T) {
+	hash := Hash("billing-secret")
+	digest := hex.EncodeToString(hash[:])
+
+	tests := []struct {
+		name         string
+		file         string
+		want         map[[32]byte]*Key
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "keys",
+			file: fmt.Sprintf(`{"keys": [{
+				"id": "billing-worker",
+				"sha256": %q,
+				"roles": ["billing"],
+				"scopes": ["invoices:write"],
+				"attrs": {"team": "payments"},
+				"expires_at": "2026-01-01T00:00:00Z",
+				"revoked": true
+			}]}`, digest),
+			want: map[[32]byte]*Key{
+				hash: {
+					ID:        "billing-worker",
+					Hash:      hash,
+					Roles:     []string{"billing"},
+					Scopes:    []string{"invoices:write"},
+					Attrs:     map[string]any{"team": "payments"},
+					ExpiresAt: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+					Revoked:   true,
+				},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "numeric attributes",
+			file: fmt.Sprintf(`{"keys": [{
+				"id": "billing-worker",
+				"sha256": %q,
+				"attrs": {"id": 100000000, "quota": 1.5, "org": {"id": 7}}
+			}]}`, digest),
+			want: map[[32]byte]*Key{
+				hash: {
+					ID:    "billing-worker",
+					Hash:  hash,
+					Attrs: map[string]any{"id": int64(100000000), "quota": 1.5, "org": map[string]any{"id": int64(7)}},
+				},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "data after the keys",
+			file: fmt.Sprintf(`{"keys": [{"id": "billing-worker", "sha256": %q}]}}`, digest),
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidKeyFile)
+			},
+		},
+		{
+			name: "not json",
+			file: `keys`,
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidKeyFile)
+			},
+		},
+		{
+			name: "empty id",
+			file: fmt.Sprintf(`{"keys": [{"sha256": %q}]}`, digest),
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, "invalid API key file: keys[0]: empty id")
+			},
+		},
+		{
+			name: "malformed digest",
+			file: `{"keys": [{"id": "billing-worker", "sha256": "billing-secret"}]}`,
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `invalid API key file: key "billing-worker": sha256 must be 64 hex digits`)
+			},
+		},
+		{
+			name: "malformed expiry",
+			file: fmt.Sprintf(`{"keys": [{"id": "billing-worker", "sha256": %q, "expires_at": "2026-01-01"}]}`, digest),
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorContains(t, err, `invalid API key file: key "billing-worker": expires_at:`)
+			},
+		},
+		{
+			name: "duplicate digest",
+			file: fmt.Sprintf(`{"keys": [{"id": "billing-worker", "sha256": %q}, {"id": "reporting", "sha256": %q}]}`, digest, digest),
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `invalid API key file: key "reporting": duplicate sha256`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseKeyFile([]byte(tt.file))
+			if !tt.errAssertion(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_FileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	hash := Hash("billing-secret")
+
+	write := func(revoked bool, modTime time.Time) {
+		file := fmt.Sprintf(`{"keys": [{"id": "billing-worker", "sha256": %q, "revoked": %t}]}`, hex.EncodeToString(hash[:]), revoked)
+		require.NoError(t, os.WriteFile(path, []byte(file), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	_, err := FileStore(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	modTime := time.Now().Add(-time.Hour)
+	write(false, modTime)
+
+	store, err := FileStore(path, WithCheckInterval(0))
+	require.NoError(t, err)
+
+	key, err := store.Lookup(context.Background(), hash)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.False(t, key.Revoked)
+
+	// Revocation is picked up.
+	modTime = modTime.Add(time.Minute)
+	write(true, modTime)
+
+	key, err = store.Lookup(context.Background(), hash)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.True(t, key.Revoked)
+
+	// A broken update fails lookups rather than keeping the previous keys.
+	modTime = modTime.Add(time.Minute)
+	require.NoError(t, os.WriteFile(path, []byte(`{"keys": [`), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+	_, err = store.Lookup(context.Background(), hash)
+	assert.ErrorIs(t, err, ErrInvalidKeyFile)
+
+	// A fixed update is picked up.
+	modTime = modTime.Add(time.Minute)
+	write(true, modTime)
+
+	key, err = store.Lookup(context.Background(), hash)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.True(t, key.Revoked)
+}
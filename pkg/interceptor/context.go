@@ -0,0 +1,40 @@
+package interceptor
+
+import (
+	"context"
+)
+
+// authorizationContextKey is the context key of the authorization of the request.
+type authorizationContextKey struct{}
+
+// authorization is what the interceptor learned about an allowed request.
+type authorization struct {
+	subject *Subject
+	result  *EvaluationResult
+}
+
+// SubjectFromContext returns the subject resolved by the interceptor for the request being handled,
+// so handlers do not need to resolve it again. Returns nil if the request is unauthenticated
+// or was not authorized by the interceptor.
+func SubjectFromContext(ctx context.Context) *Subject {
+	if auth, ok := ctx.Value(authorizationContextKey{}).(*authorization); ok {
+		return auth.subject
+	}
+
+	return nil
+}
+
+// DecisionFromContext returns the result of the access rule evaluation that allowed the request being handled.
+// Returns nil if the request was not authorized by the interceptor.
+func DecisionFromContext(ctx context.Context) *EvaluationResult {
+	if auth, ok := ctx.Value(authorizationContextKey{}).(*authorization); ok {
+		return auth.result
+	}
+
+	return nil
+}
+
+// contextWithAuthorization returns a copy of the context carrying the subject and the evaluation result.
+func contextWithAuthorization(ctx context.Context, subject *Subject, result *EvaluationResult) context.Context {
+	return context.WithValue(ctx, authorizationContextKey{}, &authorization{subject: subject, result: result})
+}
@@ -0,0 +1,67 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func Test_contextWithAuthorization(t *testing.T) {
+	subject := &Subject{Roles: []string{"user"}}
+	result := &EvaluationResult{Allowed: true, Rule: RuleKindAuthenticated}
+
+	ctx := contextWithAuthorization(context.Background(), subject, result)
+	assert.Same(t, subject, SubjectFromContext(ctx))
+	assert.Same(t, result, DecisionFromContext(ctx))
+
+	assert.Nil(t, SubjectFromContext(context.Background()))
+	assert.Nil(t, DecisionFromContext(context.Background()))
+}
+
+func Test_interceptor_authorizationContext(t *testing.T) {
+	subject := &Subject{Roles: []string{"user"}, Scheme: "jwt"}
+	server := mockGuardServiceProvider{service: &guard.Service{
+		Name:  "Service",
+		Rules: guard.Rules{{RequireAuthentication: guard.Ptr(true)}},
+	}}
+	want := &EvaluationResult{Allowed: true, Rule: RuleKindAuthenticated}
+
+	i := New(func(context.Context) (*Subject, error) { return subject, nil })
+
+	t.Run("unary", func(t *testing.T) {
+		var handled bool
+
+		info := &grpc.UnaryServerInfo{Server: server, FullMethod: "/pkg.Service/Method"}
+		_, err := i.Unary()(context.Background(), &emptypb.Empty{}, info, func(ctx context.Context, req any) (any, error) {
+			handled = true
+
+			assert.Same(t, subject, SubjectFromContext(ctx))
+			assert.Equal(t, want, DecisionFromContext(ctx))
+
+			return &emptypb.Empty{}, nil
+		})
+		require.NoError(t, err)
+		assert.True(t, handled)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		var handled bool
+
+		info := &grpc.StreamServerInfo{FullMethod: "/pkg.Service/Method"}
+		err := i.Stream()(server, &mockServerStream{ctx: context.Background()}, info, func(_ any, ss grpc.ServerStream) error {
+			handled = true
+
+			assert.Same(t, subject, SubjectFromContext(ss.Context()))
+			assert.Equal(t, want, DecisionFromContext(ss.Context()))
+
+			return nil
+		})
+		require.NoError(t, err)
+		assert.True(t, handled)
+	})
+}
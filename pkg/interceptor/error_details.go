@@ -0,0 +1,160 @@
+package interceptor
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// DefaultErrorDomain is the domain of ErrorInfo details attached to denials by default.
+const DefaultErrorDomain = "protoc-gen-go-guard"
+
+// Reasons of ErrorInfo details attached to denials, by the kind of the denying rule.
+const (
+	ReasonUnauthenticated     = "UNAUTHENTICATED"
+	ReasonAuthLevelTooLow     = "AUTH_LEVEL_TOO_LOW"
+	ReasonAuthTooOld          = "AUTH_TOO_OLD"
+	ReasonMissingRole         = "MISSING_ROLE"
+	ReasonMissingScope        = "MISSING_SCOPE"
+	ReasonPolicyDenied        = "POLICY_DENIED"
+	ReasonNotOwner            = "NOT_OWNER"
+	ReasonConditionFailed     = "CONDITION_FAILED"
+	ReasonExplicitlyDenied    = "EXPLICITLY_DENIED"
+	ReasonOutsideTimeWindow   = "OUTSIDE_TIME_WINDOW"
+	ReasonNetworkDenied       = "NETWORK_DENIED"
+	ReasonMetadataMismatch    = "METADATA_MISMATCH"
+	ReasonWorkloadDenied      = "WORKLOAD_DENIED"
+	ReasonFieldWriteProtected = "FIELD_WRITE_PROTECTED"
+	ReasonAccessDenied        = "ACCESS_DENIED"
+)
+
+// ErrorInfo metadata keys. Lists of roles, scopes and policies are comma-separated.
+const (
+	MetadataRule           = "rule"            // Kind of the denying rule.
+	MetadataMatchedRule    = "matched_rule"    // Kind of the matched deny rule.
+	MetadataField          = "field"           // Path of the write-protected request field.
+	MetadataKey            = "metadata_key"    // Incoming metadata key the request does not match.
+	MetadataAuthLevel      = "auth_level"      // Authentication level the subject must step up to.
+	MetadataMaxAuthAge     = "max_auth_age"    // Maximum time since authentication, in seconds.
+	MetadataMissingRoles   = "missing_roles"   // Listed roles the subject does not have.
+	MetadataMissingScopes  = "missing_scopes"  // Listed scopes the subject is not granted.
+	MetadataFailedPolicies = "failed_policies" // Listed policies that did not pass.
+)
+
+// missingChecks maps checks reporting missing items to ErrorInfo metadata keys
+// and PreconditionFailure violation types, in the order they are reported.
+var missingChecks = []struct {
+	kind          RuleKind
+	metadataKey   string
+	violationType string
+}{
+	{RuleKindRoleBased, MetadataMissingRoles, "ROLE"},
+	{RuleKindScopeBased, MetadataMissingScopes, "SCOPE"},
+	{RuleKindPolicyBased, MetadataFailedPolicies, ""},
+}
+
+// errorReason returns the stable ErrorInfo reason for the kind of the denying rule.
+func errorReason(kind RuleKind) string {
+	switch kind {
+	case RuleKindAuthenticated:
+		return ReasonUnauthenticated
+	case RuleKindAuthLevel:
+		return ReasonAuthLevelTooLow
+	case RuleKindAuthAge:
+		return ReasonAuthTooOld
+	case RuleKindRoleBased:
+		return ReasonMissingRole
+	case RuleKindScopeBased:
+		return ReasonMissingScope
+	case RuleKindPolicyBased:
+		return ReasonPolicyDenied
+	case RuleKindOwnership:
+		return ReasonNotOwner
+	case RuleKindCondition:
+		return ReasonConditionFailed
+	case RuleKindDeny:
+		return ReasonExplicitlyDenied
+	case RuleKindTimeWindow:
+		return ReasonOutsideTimeWindow
+	case RuleKindNetwork:
+		return ReasonNetworkDenied
+	case RuleKindMetadata:
+		return ReasonMetadataMismatch
+	case RuleKindWorkload:
+		return ReasonWorkloadDenied
+	case RuleKindWriteProtected:
+		return ReasonFieldWriteProtected
+	default:
+		return ReasonAccessDenied
+	}
+}
+
+// errorDetails builds the status details of a denied result:
+// ErrorInfo with a stable reason and metadata describing the denial, and
+// PreconditionFailure with a violation per missing role and scope, if there are any.
+func errorDetails(result *EvaluationResult, domain string) []protoadapt.MessageV1 {
+	info := &errdetails.ErrorInfo{
+		Reason: errorReason(result.Rule),
+		Domain: domain,
+		Metadata: map[string]string{
+			MetadataRule: string(result.Rule),
+		},
+	}
+
+	switch {
+	case result.Rule == RuleKindDeny && len(result.Details) > 0:
+		info.Metadata[MetadataMatchedRule] = result.Details[0]
+	case result.Rule == RuleKindWriteProtected && len(result.Details) > 0:
+		info.Metadata[MetadataField] = result.Details[0]
+	case result.Rule == RuleKindMetadata && len(result.Details) > 0:
+		info.Metadata[MetadataKey] = result.Details[0]
+	case result.Rule == RuleKindAuthLevel && len(result.Details) > 0:
+		info.Metadata[MetadataAuthLevel] = result.Details[0]
+	case result.Rule == RuleKindAuthAge && len(result.Details) > 0:
+		info.Metadata[MetadataMaxAuthAge] = result.Details[0]
+	}
+
+	var violations []*errdetails.PreconditionFailure_Violation
+
+	for _, check := range missingChecks {
+		items := result.Missing[check.kind]
+		if len(items) == 0 {
+			continue
+		}
+
+		info.Metadata[check.metadataKey] = strings.Join(items, ",")
+
+		if check.violationType == "" {
+			continue
+		}
+
+		for _, item := range items {
+			violations = append(violations, &errdetails.PreconditionFailure_Violation{
+				Type:        check.violationType,
+				Subject:     item,
+				Description: fmt.Sprintf("%s %q is required", strings.ToLower(check.violationType), item),
+			})
+		}
+	}
+
+	details := []protoadapt.MessageV1{info}
+	if len(violations) > 0 {
+		details = append(details, &errdetails.PreconditionFailure{Violations: violations})
+	}
+
+	return details
+}
+
+// deniedStatus returns the status with details of the denied result attached.
+// The status is returned as is if the details cannot be attached.
+func deniedStatus(st *status.Status, result *EvaluationResult, domain string) *status.Status {
+	withDetails, err := st.WithDetails(errorDetails(result, domain)...)
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
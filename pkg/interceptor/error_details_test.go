@@ -0,0 +1,164 @@
+package interceptor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+func Test_errorDetails(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *EvaluationResult
+		want   []protoadapt.MessageV1
+	}{
+		{
+			name:   "unauthenticated",
+			result: &EvaluationResult{Rule: RuleKindAuthenticated},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason:   ReasonUnauthenticated,
+					Domain:   "example.com",
+					Metadata: map[string]string{MetadataRule: "authenticated"},
+				},
+			},
+		},
+		{
+			name: "missing roles and failed policies",
+			result: &EvaluationResult{
+				Rule: RuleKindRoleBased,
+				Missing: map[RuleKind][]string{
+					RuleKindRoleBased:   {"editor", "admin"},
+					RuleKindPolicyBased: {"owner"},
+				},
+			},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason: ReasonMissingRole,
+					Domain: "example.com",
+					Metadata: map[string]string{
+						MetadataRule:           "role-based",
+						MetadataMissingRoles:   "editor,admin",
+						MetadataFailedPolicies: "owner",
+					},
+				},
+				&errdetails.PreconditionFailure{
+					Violations: []*errdetails.PreconditionFailure_Violation{
+						{Type: "ROLE", Subject: "editor", Description: `role "editor" is required`},
+						{Type: "ROLE", Subject: "admin", Description: `role "admin" is required`},
+					},
+				},
+			},
+		},
+		{
+			name:   "failed policy",
+			result: &EvaluationResult{Rule: RuleKindPolicyBased, Missing: map[RuleKind][]string{RuleKindPolicyBased: {"owner"}}},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason: ReasonPolicyDenied,
+					Domain: "example.com",
+					Metadata: map[string]string{
+						MetadataRule:           "policy-based",
+						MetadataFailedPolicies: "owner",
+					},
+				},
+			},
+		},
+		{
+			name:   "deny rule",
+			result: &EvaluationResult{Rule: RuleKindDeny, Details: []string{string(RuleKindRoleBased)}},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason: ReasonExplicitlyDenied,
+					Domain: "example.com",
+					Metadata: map[string]string{
+						MetadataRule:        "deny",
+						MetadataMatchedRule: "role-based",
+					},
+				},
+			},
+		},
+		{
+			name:   "write-protected field",
+			result: &EvaluationResult{Rule: RuleKindWriteProtected, Details: []string{"user.role"}},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason: ReasonFieldWriteProtected,
+					Domain: "example.com",
+					Metadata: map[string]string{
+						MetadataRule:  "write-protected",
+						MetadataField: "user.role",
+					},
+				},
+			},
+		},
+		{
+			name:   "insufficient auth level",
+			result: &EvaluationResult{Rule: RuleKindAuthLevel, Details: []string{"2"}},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason: ReasonAuthLevelTooLow,
+					Domain: "example.com",
+					Metadata: map[string]string{
+						MetadataRule:      "auth-level",
+						MetadataAuthLevel: "2",
+					},
+				},
+			},
+		},
+		{
+			name:   "authentication too old",
+			result: &EvaluationResult{Rule: RuleKindAuthAge, Details: []string{"300"}},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason: ReasonAuthTooOld,
+					Domain: "example.com",
+					Metadata: map[string]string{
+						MetadataRule:       "auth-age",
+						MetadataMaxAuthAge: "300",
+					},
+				},
+			},
+		},
+		{
+			name:   "metadata mismatch",
+			result: &EvaluationResult{Rule: RuleKindMetadata, Details: []string{"x-client-app"}},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason: ReasonMetadataMismatch,
+					Domain: "example.com",
+					Metadata: map[string]string{
+						MetadataRule: "metadata",
+						MetadataKey:  "x-client-app",
+					},
+				},
+			},
+		},
+		{
+			name:   "private",
+			result: &EvaluationResult{Rule: RuleKindPrivate},
+			want: []protoadapt.MessageV1{
+				&errdetails.ErrorInfo{
+					Reason:   ReasonAccessDenied,
+					Domain:   "example.com",
+					Metadata: map[string]string{MetadataRule: "private"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := errorDetails(tt.result, "example.com")
+			require.Len(t, got, len(tt.want))
+
+			for idx := range tt.want {
+				assert.True(t, proto.Equal(protoadapt.MessageV2Of(tt.want[idx]), protoadapt.MessageV2Of(got[idx])), "got %v", got[idx])
+			}
+		})
+	}
+}
@@ -4,8 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/casnerano/protoc-gen-go-guard/pkg/condition"
 	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/google/cel-go/cel"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 var (
@@ -14,6 +22,7 @@ var (
 )
 
 // evaluateRules checks a list of rules in order. Access is granted if any rule allows it.
+// Otherwise the result of the first rule is returned, carrying the denial status declared by the rule.
 func (i *Interceptor) evaluateRules(ctx context.Context, rules guard.Rules, input *Input) (*EvaluationResult, error) {
 	var firstDeniedResult *EvaluationResult
 
@@ -28,6 +37,7 @@ func (i *Interceptor) evaluateRules(ctx context.Context, rules guard.Rules, inpu
 		}
 
 		if firstDeniedResult == nil {
+			result.Denial = rule.Denial
 			firstDeniedResult = result
 		}
 	}
@@ -39,8 +49,70 @@ func (i *Interceptor) evaluateRules(ctx context.Context, rules guard.Rules, inpu
 	return &EvaluationResult{Allowed: false, Rule: RuleKindPrivate}, nil
 }
 
+// evaluateRuleGroups checks groups of rules in order. Access is granted if every group allows it.
+// Returns the result of the first denying group, or of the last group when all of them allow access.
+func (i *Interceptor) evaluateRuleGroups(ctx context.Context, groups []guard.Rules, input *Input) (*EvaluationResult, error) {
+	result := &EvaluationResult{Allowed: false, Rule: RuleKindPrivate}
+
+	for _, rules := range groups {
+		var err error
+		if result, err = i.evaluateRules(ctx, rules, input); err != nil {
+			return nil, err
+		}
+
+		if !result.Allowed {
+			return result, nil
+		}
+	}
+
+	return result, nil
+}
+
+// evaluateDenyRules checks deny rules in order. Access is denied by the first rule that matches,
+// i.e. that would allow access as a regular rule. Returns nil if no deny rule matches.
+// The details of the result hold the kind of the matched rule,
+// and the result carries the denial status declared by the rule.
+func (i *Interceptor) evaluateDenyRules(ctx context.Context, rules guard.Rules, input *Input) (*EvaluationResult, error) {
+	for _, rule := range rules {
+		result, err := i.evaluateRule(ctx, rule, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Allowed {
+			return &EvaluationResult{
+				Allowed: false,
+				Rule:    RuleKindDeny,
+				Details: []string{string(result.Rule)},
+				Denial:  rule.Denial,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
 // evaluateRule checks a single rule.
+// Outside of its time constraints or network, and for requests not matching its metadata,
+// the rule denies access regardless of its mode. The details of a metadata denial hold the mismatched key.
 func (i *Interceptor) evaluateRule(ctx context.Context, rule *guard.Rule, input *Input) (*EvaluationResult, error) {
+	active, err := i.ruleActive(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	if !active {
+		return &EvaluationResult{Allowed: false, Rule: RuleKindTimeWindow}, nil
+	}
+
+	if rule.Network != nil && !networkAllowed(rule.Network, input.Peer) {
+		return &EvaluationResult{Allowed: false, Rule: RuleKindNetwork}, nil
+	}
+
+	if key, mismatched := metadataMismatch(ctx, rule.Metadata); mismatched {
+		return &EvaluationResult{Allowed: false, Rule: RuleKindMetadata, Details: []string{key}}, nil
+	}
+
 	if rule.AllowPublic != nil && *rule.AllowPublic {
 		return &EvaluationResult{Allowed: true, Rule: RuleKindPublic}, nil
 	}
@@ -58,108 +130,405 @@ func (i *Interceptor) evaluateRule(ctx context.Context, rule *guard.Rule, input
 			return &EvaluationResult{Allowed: false, Rule: RuleKindAuthenticated}, nil
 		}
 
-		var (
-			err error
+		return i.evaluateAuthenticatedAccess(ctx, rule.AuthenticatedAccess, input)
+	}
 
-			allowedRoleBased   bool
-			allowedPolicyBased bool
-		)
+	if rule.Condition != nil {
+		if !input.Authenticated() {
+			return &EvaluationResult{Allowed: false, Rule: RuleKindAuthenticated}, nil
+		}
 
-		if rule.AuthenticatedAccess.RoleBased != nil {
-			allowedRoleBased, err = i.evaluateRoleBasedAccess(ctx, rule.AuthenticatedAccess.RoleBased, input)
-			if err != nil {
-				return nil, err
-			}
+		allowed, err := i.evaluateCondition(ctx, *rule.Condition, input)
+		if err != nil {
+			return nil, err
+		}
 
-			if !allowedRoleBased {
-				return &EvaluationResult{Allowed: false, Rule: RuleKindRoleBased}, nil
-			}
+		return &EvaluationResult{Allowed: allowed, Rule: RuleKindCondition}, nil
+	}
+
+	if rule.Workload != nil {
+		return &EvaluationResult{Allowed: workloadAllowed(rule.Workload, input.Certificate), Rule: RuleKindWorkload}, nil
+	}
+
+	return &EvaluationResult{Allowed: false, Rule: RuleKindPrivate}, nil
+}
+
+// accessCheck is one configured check of AuthenticatedAccess.
+// Its evaluation reports the listed items that the subject failed to match.
+type accessCheck struct {
+	kind     RuleKind
+	evaluate func() (bool, []string, error)
+}
+
+// evaluateAuthenticatedAccess evaluates the configured role-based, scope-based, policy-based
+// and ownership checks, combined according to the access combine mode:
+//   - CombineAll — every check must pass; the first failed check is reported;
+//   - CombineAny — one passed check is enough; later checks are not evaluated.
+//
+// The details of an allowing result hold the kinds of the checks that granted access,
+// and of a denying CombineAny result — the kinds of all failed checks.
+// A denying result lists the roles, scopes and policies missing for the reported checks.
+//
+// Step-up requirements are checked first and must be met in either mode.
+// Access with step-up requirements only is allowed to every subject meeting them.
+func (i *Interceptor) evaluateAuthenticatedAccess(ctx context.Context, access *guard.AuthenticatedAccess, input *Input) (*EvaluationResult, error) {
+	if result := i.evaluateStepUp(access, input.Subject); result != nil {
+		return result, nil
+	}
+
+	var checks []accessCheck
+
+	if access.RoleBased != nil {
+		checks = append(checks, accessCheck{RuleKindRoleBased, func() (bool, []string, error) {
+			return i.evaluateRoleBasedAccess(ctx, access.RoleBased, input)
+		}})
+	}
+
+	if access.ScopeBased != nil {
+		checks = append(checks, accessCheck{RuleKindScopeBased, func() (bool, []string, error) {
+			return i.evaluateScopeBasedAccess(ctx, access.ScopeBased, input)
+		}})
+	}
+
+	if access.PolicyBased != nil {
+		checks = append(checks, accessCheck{RuleKindPolicyBased, func() (bool, []string, error) {
+			return i.evaluatePolicyBasedAccess(ctx, access.PolicyBased, input)
+		}})
+	}
+
+	if access.Ownership != nil {
+		checks = append(checks, accessCheck{RuleKindOwnership, func() (bool, []string, error) {
+			return evaluateOwnership(access.Ownership, input), nil, nil
+		}})
+	}
+
+	if len(checks) == 0 {
+		if kind, ok := stepUpKind(access); ok {
+			return &EvaluationResult{Allowed: true, Rule: kind}, nil
 		}
 
-		if rule.AuthenticatedAccess.PolicyBased != nil {
-			allowedPolicyBased, err = i.evaluatePolicyBasedAccess(ctx, rule.AuthenticatedAccess.PolicyBased, input)
-			if err != nil {
-				return nil, err
-			}
+		return &EvaluationResult{Allowed: false, Rule: RuleKindPrivate}, nil
+	}
 
-			if !allowedPolicyBased {
-				return &EvaluationResult{Allowed: false, Rule: RuleKindPolicyBased}, nil
-			}
+	var (
+		details []string
+		missing map[RuleKind][]string
+	)
+
+	for _, check := range checks {
+		allowed, unmatched, err := check.evaluate()
+		if err != nil {
+			return nil, err
 		}
 
-		allowed := allowedRoleBased || allowedPolicyBased
-		ruleKind := RuleKindRoleBased
-		if rule.AuthenticatedAccess.PolicyBased != nil {
-			ruleKind = RuleKindPolicyBased
+		if !allowed && len(unmatched) > 0 {
+			if missing == nil {
+				missing = make(map[RuleKind][]string)
+			}
+
+			missing[check.kind] = unmatched
 		}
-		if !allowed {
-			return &EvaluationResult{Allowed: false, Rule: RuleKindPrivate}, nil
+
+		switch {
+		case allowed && access.Combine == guard.CombineAny:
+			return &EvaluationResult{Allowed: true, Rule: check.kind, Details: []string{string(check.kind)}}, nil
+		case !allowed && access.Combine == guard.CombineAll:
+			return &EvaluationResult{Allowed: false, Rule: check.kind, Missing: missing}, nil
 		}
-		return &EvaluationResult{Allowed: true, Rule: ruleKind}, nil
+
+		details = append(details, string(check.kind))
 	}
 
-	return &EvaluationResult{Allowed: false, Rule: RuleKindPrivate}, nil
+	if access.Combine == guard.CombineAny {
+		return &EvaluationResult{Allowed: false, Rule: checks[0].kind, Details: details, Missing: missing}, nil
+	}
+
+	return &EvaluationResult{Allowed: true, Rule: checks[len(checks)-1].kind, Details: details}, nil
 }
 
 // evaluateRoleBasedAccess checks if the subject satisfies the role-based conditions.
-func (i *Interceptor) evaluateRoleBasedAccess(_ context.Context, roleBased *guard.RoleBased, input *Input) (bool, error) {
+// Returns the listed roles the subject does not have.
+func (i *Interceptor) evaluateRoleBasedAccess(_ context.Context, roleBased *guard.RoleBased, input *Input) (bool, []string, error) {
 	if len(roleBased.Roles) == 0 {
-		return false, nil
+		return false, nil, nil
 	}
 
-	var matchedRolesCount int
+	subjectRoles := expandRoles(input.Subject.Roles, i.roleHierarchy, input.roleHierarchy)
+
+	var missingRoles []string
 	for _, requiredRole := range roleBased.Roles {
-		for _, subjectRole := range input.Subject.Roles {
-			if requiredRole == subjectRole {
-				matchedRolesCount++
-				break
-			}
+		if _, exists := subjectRoles[requiredRole]; !exists {
+			missingRoles = append(missingRoles, requiredRole)
 		}
 	}
 
-	switch roleBased.Requirement {
-	case guard.RequirementAll:
-		return matchedRolesCount == len(roleBased.Roles), nil
-	case guard.RequirementAtLeastOne:
-		return matchedRolesCount > 0, nil
-	default:
-		return false, fmt.Errorf("unknown roles requirement type")
+	allowed, err := satisfiesRequirement(roleBased.Requirement, roleBased.Threshold, len(roleBased.Roles)-len(missingRoles), len(roleBased.Roles))
+	if err != nil {
+		return false, nil, fmt.Errorf("roles: %w", err)
+	}
+
+	return allowed, missingItems(roleBased.Requirement, missingRoles), nil
+}
+
+// expandRoles returns the roles together with all roles they imply, transitively, in any of the hierarchies.
+func expandRoles(roles []string, hierarchies ...guard.RoleHierarchy) map[string]struct{} {
+	expanded := make(map[string]struct{}, len(roles))
+
+	queue := append([]string(nil), roles...)
+	for len(queue) > 0 {
+		role := queue[0]
+		queue = queue[1:]
+
+		if _, exists := expanded[role]; exists {
+			continue
+		}
+
+		expanded[role] = struct{}{}
+
+		for _, hierarchy := range hierarchies {
+			queue = append(queue, hierarchy[role]...)
+		}
+	}
+
+	return expanded
+}
+
+// evaluateScopeBasedAccess checks if the scopes granted to the subject satisfy the scope-based conditions.
+// Returns the listed scopes the subject is not granted.
+func (i *Interceptor) evaluateScopeBasedAccess(_ context.Context, scopeBased *guard.ScopeBased, input *Input) (bool, []string, error) {
+	if len(scopeBased.Scopes) == 0 {
+		return false, nil, nil
+	}
+
+	var missingScopes []string
+	for _, requiredScope := range scopeBased.Scopes {
+		if !slices.Contains(input.Subject.Scopes, requiredScope) {
+			missingScopes = append(missingScopes, requiredScope)
+		}
+	}
+
+	allowed, err := satisfiesRequirement(scopeBased.Requirement, scopeBased.Threshold, len(scopeBased.Scopes)-len(missingScopes), len(scopeBased.Scopes))
+	if err != nil {
+		return false, nil, fmt.Errorf("scopes: %w", err)
 	}
+
+	return allowed, missingItems(scopeBased.Requirement, missingScopes), nil
 }
 
 // evaluatePolicyBasedAccess checks if policies allow access.
-func (i *Interceptor) evaluatePolicyBasedAccess(ctx context.Context, policyBased *guard.PolicyBased, input *Input) (bool, error) {
+// Returns the listed policies that did not pass.
+func (i *Interceptor) evaluatePolicyBasedAccess(ctx context.Context, policyBased *guard.PolicyBased, input *Input) (bool, []string, error) {
 	if len(policyBased.Policies) == 0 {
-		return false, nil
+		return false, nil, nil
 	}
 
-	var passedPoliciesCount int
+	var failedPolicies []string
 	for _, policyName := range policyBased.Policies {
 		policy, exists := i.policies[policyName]
 		if !exists {
-			return false, fmt.Errorf("policy %q not defined: %w", policyName, ErrUndefinedPolicy)
+			return false, nil, fmt.Errorf("policy %q not defined: %w", policyName, ErrUndefinedPolicy)
 		}
 
 		if policy == nil {
-			return false, fmt.Errorf("policy %q is nil: %w", policyName, ErrInvalidPolicy)
+			return false, nil, fmt.Errorf("policy %q is nil: %w", policyName, ErrInvalidPolicy)
 		}
 
-		allowed, err := policy(ctx, input)
+		policyInput := *input
+		policyInput.Args = policyBased.Args[policyName]
+
+		allowed, err := policy(ctx, &policyInput)
 		if err != nil {
-			return false, err
+			return false, nil, err
 		}
 
-		if allowed {
-			passedPoliciesCount++
+		if !allowed {
+			failedPolicies = append(failedPolicies, policyName)
 		}
 	}
 
-	switch policyBased.Requirement {
+	allowed, err := satisfiesRequirement(policyBased.Requirement, policyBased.Threshold, len(policyBased.Policies)-len(failedPolicies), len(policyBased.Policies))
+	if err != nil {
+		return false, nil, fmt.Errorf("policies: %w", err)
+	}
+
+	return allowed, missingItems(policyBased.Requirement, failedPolicies), nil
+}
+
+// missingItems returns the unmatched items unless the requirement forbids matches,
+// in which case the unmatched items are not the cause of a denial.
+func missingItems(requirement guard.Requirement, unmatched []string) []string {
+	if requirement == guard.RequirementNone {
+		return nil
+	}
+
+	return unmatched
+}
+
+// satisfiesRequirement checks if the number of matched items out of total satisfies the requirement.
+func satisfiesRequirement(requirement guard.Requirement, threshold, matched, total int) (bool, error) {
+	switch requirement {
 	case guard.RequirementAll:
-		return passedPoliciesCount == len(policyBased.Policies), nil
+		return matched == total, nil
 	case guard.RequirementAtLeastOne:
-		return passedPoliciesCount > 0, nil
+		return matched > 0, nil
+	case guard.RequirementNone:
+		return matched == 0, nil
+	case guard.RequirementAtLeast:
+		if threshold < 1 {
+			return false, fmt.Errorf("invalid threshold %d", threshold)
+		}
+
+		return matched >= threshold, nil
 	default:
-		return false, fmt.Errorf("unknown requredPolicies requirement type")
+		return false, fmt.Errorf("unknown requirement type")
+	}
+}
+
+// evaluateOwnership checks if the request field equals the subject attribute.
+// String fields match string attributes, and integer fields match attributes of any Go integer type
+// with the same value. Access is denied if the request is of an unexpected type, the field is empty,
+// or the subject has no such attribute or one of another type.
+func evaluateOwnership(ownership *guard.Ownership, input *Input) bool {
+	if ownership.RequestValue == nil {
+		return false
+	}
+
+	requestValue, ok := ownership.RequestValue(input.Request)
+	if !ok {
+		return false
+	}
+
+	owner, ok := ownerValue(requestValue)
+	if !ok || owner == (owned{}) {
+		return false
 	}
+
+	subject, ok := ownerValue(input.Subject.Attrs[ownership.SubjectAttr])
+
+	return ok && owner == subject
+}
+
+// owned is the comparable form of an ownership value: a string, or an integer in decimal.
+type owned struct {
+	value   string
+	integer bool
+}
+
+// ownerValue returns the comparable form of a string or integer value.
+func ownerValue(value any) (owned, bool) {
+	switch v := value.(type) {
+	case string:
+		return owned{value: v}, true
+	case int:
+		return owned{value: strconv.FormatInt(int64(v), 10), integer: true}, true
+	case int8:
+		return owned{value: strconv.FormatInt(int64(v), 10), integer: true}, true
+	case int16:
+		return owned{value: strconv.FormatInt(int64(v), 10), integer: true}, true
+	case int32:
+		return owned{value: strconv.FormatInt(int64(v), 10), integer: true}, true
+	case int64:
+		return owned{value: strconv.FormatInt(v, 10), integer: true}, true
+	case uint:
+		return owned{value: strconv.FormatUint(uint64(v), 10), integer: true}, true
+	case uint8:
+		return owned{value: strconv.FormatUint(uint64(v), 10), integer: true}, true
+	case uint16:
+		return owned{value: strconv.FormatUint(uint64(v), 10), integer: true}, true
+	case uint32:
+		return owned{value: strconv.FormatUint(uint64(v), 10), integer: true}, true
+	case uint64:
+		return owned{value: strconv.FormatUint(v, 10), integer: true}, true
+	default:
+		return owned{}, false
+	}
+}
+
+// conditionKey identifies a compiled condition program by expression and request message type.
+type conditionKey struct {
+	expression string
+	request    protoreflect.FullName
+}
+
+// evaluateCondition evaluates a CEL condition against the subject, request and incoming metadata.
+// Compiled programs are cached per expression and request message type.
+func (i *Interceptor) evaluateCondition(ctx context.Context, expression string, input *Input) (bool, error) {
+	var requestDesc protoreflect.MessageDescriptor
+
+	activation := map[string]any{
+		condition.VariableSubject: map[string]any{
+			"roles":  input.Subject.Roles,
+			"scopes": input.Subject.Scopes,
+			"attrs":  input.Subject.Attrs,
+			"scheme": input.Subject.Scheme,
+		},
+		condition.VariableMetadata: map[string][]string{},
+	}
+
+	if request, ok := input.Request.(proto.Message); ok && request != nil {
+		requestDesc = request.ProtoReflect().Descriptor()
+		activation[condition.VariableRequest] = request
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		activation[condition.VariableMetadata] = map[string][]string(md)
+	}
+
+	program, err := i.getConditionProgram(expression, requestDesc)
+	if err != nil {
+		return false, err
+	}
+
+	value, _, err := program.ContextEval(ctx, activation)
+	if err != nil {
+		// A subject attribute, metadata key or field the input lacks does not match the condition.
+		if isMissingValue(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("condition %q evaluation failed: %w", expression, err)
+	}
+
+	allowed, ok := value.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("condition %q returned non-bool value: %w", expression, condition.ErrInvalidCondition)
+	}
+
+	return allowed, nil
+}
+
+// isMissingValue reports whether a CEL evaluation error is caused by a map key or a field
+// absent from the evaluated values. CEL does not export these errors, so they are matched by message.
+func isMissingValue(err error) bool {
+	message := err.Error()
+
+	return strings.HasPrefix(message, "no such key") ||
+		strings.HasPrefix(message, "no such field") ||
+		strings.HasPrefix(message, "no such attribute")
+}
+
+// getConditionProgram returns a cached CEL program, compiling it on first use.
+func (i *Interceptor) getConditionProgram(expression string, requestDesc protoreflect.MessageDescriptor) (cel.Program, error) {
+	key := conditionKey{expression: expression}
+	if requestDesc != nil {
+		key.request = requestDesc.FullName()
+	}
+
+	if program, ok := i.conditions.Load(key); ok {
+		return program.(cel.Program), nil
+	}
+
+	env, err := condition.NewEnv(requestDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := condition.Compile(env, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	i.conditions.Store(key, program)
+
+	return program, nil
 }
@@ -2,12 +2,19 @@ package interceptor
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
+	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func Test_interceptor_evaluateRules(t *testing.T) {
@@ -79,6 +86,38 @@ func Test_interceptor_evaluateRules(t *testing.T) {
 			}},
 			want: &EvaluationResult{Allowed: false, Rule: RuleKindPolicyBased},
 		},
+		{
+			name:  "first denied rule denial with subject",
+			input: Input{Subject: &Subject{Roles: []string{"user"}}},
+			rules: guard.Rules{
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{Roles: []string{"premium"}},
+					},
+					Denial: &guard.Denial{Code: 9, Message: "upgrade required"},
+				},
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{Roles: []string{"admin"}},
+					},
+					Denial: &guard.Denial{Code: 5},
+				},
+			},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindRoleBased, Denial: &guard.Denial{Code: 9, Message: "upgrade required"}},
+		},
+		{
+			name:  "condition on missing subject attribute before matching role rule",
+			input: Input{Request: &apipb.Api{Name: "tenant-1"}, Subject: &Subject{Roles: []string{"admin"}}},
+			rules: guard.Rules{
+				{Condition: guard.Ptr("subject.attrs.tenant_id == request.name")},
+				{
+					AuthenticatedAccess: &guard.AuthenticatedAccess{
+						RoleBased: &guard.RoleBased{Roles: []string{"admin"}},
+					},
+				},
+			},
+			want: &EvaluationResult{Allowed: true, Rule: RuleKindRoleBased},
+		},
 		{
 			name:  "one policy rule when unknown requirement type with subject",
 			input: Input{Subject: &Subject{}},
@@ -115,6 +154,150 @@ func Test_interceptor_evaluateRules(t *testing.T) {
 			require.NotNil(t, tt.want)
 			assert.Equal(t, tt.want.Allowed, result.Allowed)
 			assert.Equal(t, tt.want.Rule, result.Rule)
+			assert.Equal(t, tt.want.Denial, result.Denial)
+		})
+	}
+}
+
+func Test_interceptor_evaluateRuleGroups(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  Input
+		groups []guard.Rules
+
+		want *EvaluationResult
+	}{
+		{
+			name:   "nil groups",
+			input:  Input{Subject: &Subject{}},
+			groups: nil,
+			want:   &EvaluationResult{Allowed: false, Rule: RuleKindPrivate},
+		},
+		{
+			name:   "one group with nil rules",
+			input:  Input{Subject: &Subject{}},
+			groups: []guard.Rules{nil},
+			want:   &EvaluationResult{Allowed: false, Rule: RuleKindPrivate},
+		},
+		{
+			name:  "all groups allow",
+			input: Input{Subject: &Subject{}},
+			groups: []guard.Rules{
+				{{AllowPublic: guard.Ptr(true)}},
+				{{RequireAuthentication: guard.Ptr(true)}},
+			},
+			want: &EvaluationResult{Allowed: true, Rule: RuleKindAuthenticated},
+		},
+		{
+			name:  "second group denies",
+			input: Input{},
+			groups: []guard.Rules{
+				{{AllowPublic: guard.Ptr(true)}},
+				{{RequireAuthentication: guard.Ptr(true)}},
+			},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindAuthenticated},
+		},
+		{
+			name:  "first group denies",
+			input: Input{Subject: &Subject{}},
+			groups: []guard.Rules{
+				{{AllowPublic: guard.Ptr(false)}},
+				{{AllowPublic: guard.Ptr(true)}},
+			},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindPrivate},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &Interceptor{}
+
+			result, err := i.evaluateRuleGroups(context.Background(), tt.groups, &tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_interceptor_evaluateDenyRules(t *testing.T) {
+	suspendedRule := &guard.Rule{
+		AuthenticatedAccess: &guard.AuthenticatedAccess{
+			RoleBased: &guard.RoleBased{
+				Roles:       []string{"suspended"},
+				Requirement: guard.RequirementAtLeastOne,
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		input Input
+		rules guard.Rules
+
+		want         *EvaluationResult
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:  "nil deny rules",
+			input: Input{Subject: &Subject{}},
+			rules: nil,
+			want:  nil,
+		},
+		{
+			name:  "deny rule does not match",
+			input: Input{Subject: &Subject{Roles: []string{"user"}}},
+			rules: guard.Rules{suspendedRule},
+			want:  nil,
+		},
+		{
+			name:  "deny rule does not match unauthenticated",
+			input: Input{},
+			rules: guard.Rules{suspendedRule},
+			want:  nil,
+		},
+		{
+			name:  "deny rule matches",
+			input: Input{Subject: &Subject{Roles: []string{"user", "suspended"}}},
+			rules: guard.Rules{suspendedRule},
+			want:  &EvaluationResult{Allowed: false, Rule: RuleKindDeny, Details: []string{string(RuleKindRoleBased)}},
+		},
+		{
+			name:  "public deny rule matches unauthenticated",
+			input: Input{},
+			rules: guard.Rules{{AllowPublic: guard.Ptr(true)}},
+			want:  &EvaluationResult{Allowed: false, Rule: RuleKindDeny, Details: []string{string(RuleKindPublic)}},
+		},
+		{
+			name:  "deny rule matches with denial",
+			input: Input{},
+			rules: guard.Rules{{AllowPublic: guard.Ptr(true), Denial: &guard.Denial{Code: 9, Message: "maintenance"}}},
+			want: &EvaluationResult{
+				Allowed: false,
+				Rule:    RuleKindDeny,
+				Details: []string{string(RuleKindPublic)},
+				Denial:  &guard.Denial{Code: 9, Message: "maintenance"},
+			},
+		},
+		{
+			name:         "deny rule evaluation error",
+			input:        Input{Subject: &Subject{}},
+			rules:        guard.Rules{{Condition: guard.Ptr("subject.roles ==")}},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &Interceptor{}
+
+			result, err := i.evaluateDenyRules(context.Background(), tt.rules, &tt.input)
+			if tt.errAssertion != nil {
+				tt.errAssertion(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
 		})
 	}
 }
@@ -153,6 +336,52 @@ func Test_interceptor_evaluateRule(t *testing.T) {
 			rule:  &guard.Rule{RequireAuthentication: guard.Ptr(true)},
 			want:  &EvaluationResult{Allowed: true, Rule: RuleKindAuthenticated},
 		},
+		{
+			name:  "allow public rule after validity period",
+			input: Input{},
+			rule:  &guard.Rule{AllowPublic: guard.Ptr(true), ValidUntil: guard.Ptr(time.Now().Add(-time.Hour))},
+			want:  &EvaluationResult{Allowed: false, Rule: RuleKindTimeWindow},
+		},
+		{
+			name:  "allow public rule within validity period",
+			input: Input{},
+			rule:  &guard.Rule{AllowPublic: guard.Ptr(true), ValidUntil: guard.Ptr(time.Now().Add(time.Hour))},
+			want:  &EvaluationResult{Allowed: true, Rule: RuleKindPublic},
+		},
+		{
+			name:  "workload rule without certificate",
+			input: Input{},
+			rule:  &guard.Rule{Workload: &guard.Workload{CommonNames: []string{"billing-worker"}}},
+			want:  &EvaluationResult{Allowed: false, Rule: RuleKindWorkload},
+		},
+		{
+			name:  "workload rule with matching certificate",
+			input: Input{Certificate: &x509.Certificate{Subject: pkix.Name{CommonName: "billing-worker"}}},
+			rule:  &guard.Rule{Workload: &guard.Workload{CommonNames: []string{"billing-worker"}}},
+			want:  &EvaluationResult{Allowed: true, Rule: RuleKindWorkload},
+		},
+		{
+			name:  "allow public rule outside of network",
+			input: Input{Peer: netip.MustParseAddr("203.0.113.5")},
+			rule:  &guard.Rule{AllowPublic: guard.Ptr(true), Network: &guard.Network{Allow: []netip.Prefix{netip.MustParsePrefix("10.8.0.0/16")}}},
+			want:  &EvaluationResult{Allowed: false, Rule: RuleKindNetwork},
+		},
+		{
+			name:  "allow public rule within network",
+			input: Input{Peer: netip.MustParseAddr("10.8.1.2")},
+			rule:  &guard.Rule{AllowPublic: guard.Ptr(true), Network: &guard.Network{Allow: []netip.Prefix{netip.MustParsePrefix("10.8.0.0/16")}}},
+			want:  &EvaluationResult{Allowed: true, Rule: RuleKindPublic},
+		},
+		{
+			name: "allow public rule without required metadata",
+			rule: &guard.Rule{AllowPublic: guard.Ptr(true), Metadata: []*guard.MetadataMatch{{Key: "x-client-app"}}},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindMetadata, Details: []string{"x-client-app"}},
+		},
+		{
+			name: "allow public rule without forbidden metadata",
+			rule: &guard.Rule{AllowPublic: guard.Ptr(true), Metadata: []*guard.MetadataMatch{{Key: "x-debug", Absent: true}}},
+			want: &EvaluationResult{Allowed: true, Rule: RuleKindPublic},
+		},
 		{
 			name:  "role based access with no requirement",
 			input: Input{Subject: &Subject{Roles: []string{"user"}}},
@@ -249,6 +478,107 @@ func Test_interceptor_evaluateRule(t *testing.T) {
 			},
 			errAssertion: assert.Error,
 		},
+		{
+			name:  "scope based access with missing scope",
+			input: Input{Subject: &Subject{Roles: []string{"orders:read"}, Scopes: []string{"profile"}}},
+			rule: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					ScopeBased: &guard.ScopeBased{
+						Scopes:      []string{"orders:read"},
+						Requirement: guard.RequirementAll,
+					},
+				},
+			},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindScopeBased},
+		},
+		{
+			name:  "scope based access with granted scope",
+			input: Input{Subject: &Subject{Scopes: []string{"orders:read"}}},
+			rule: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					ScopeBased: &guard.ScopeBased{
+						Scopes:      []string{"orders:read"},
+						Requirement: guard.RequirementAll,
+					},
+				},
+			},
+			want: &EvaluationResult{Allowed: true, Rule: RuleKindScopeBased},
+		},
+		{
+			name:  "role based and scope based access with missing scope",
+			input: Input{Subject: &Subject{Roles: []string{"user"}}},
+			rule: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					RoleBased: &guard.RoleBased{
+						Roles:       []string{"user"},
+						Requirement: guard.RequirementAtLeastOne,
+					},
+					ScopeBased: &guard.ScopeBased{
+						Scopes:      []string{"orders:read"},
+						Requirement: guard.RequirementAtLeastOne,
+					},
+				},
+			},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindScopeBased},
+		},
+		{
+			name:  "ownership allows access",
+			input: Input{Request: &apipb.Api{Name: "user-1"}, Subject: &Subject{Attrs: map[string]any{"id": "user-1"}}},
+			rule: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					Ownership: testOwnership(),
+				},
+			},
+			want: &EvaluationResult{Allowed: true, Rule: RuleKindOwnership},
+		},
+		{
+			name:  "ownership denies access",
+			input: Input{Request: &apipb.Api{Name: "user-2"}, Subject: &Subject{Attrs: map[string]any{"id": "user-1"}}},
+			rule: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					Ownership: testOwnership(),
+				},
+			},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindOwnership},
+		},
+		{
+			name:  "role based access and ownership with wrong owner",
+			input: Input{Request: &apipb.Api{Name: "user-2"}, Subject: &Subject{Roles: []string{"user"}, Attrs: map[string]any{"id": "user-1"}}},
+			rule: &guard.Rule{
+				AuthenticatedAccess: &guard.AuthenticatedAccess{
+					RoleBased: &guard.RoleBased{
+						Roles:       []string{"user"},
+						Requirement: guard.RequirementAtLeastOne,
+					},
+					Ownership: testOwnership(),
+				},
+			},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindOwnership},
+		},
+		{
+			name:  "condition without subject",
+			input: Input{},
+			rule:  &guard.Rule{Condition: guard.Ptr("true")},
+			want:  &EvaluationResult{Allowed: false, Rule: RuleKindAuthenticated},
+		},
+		{
+			name:  "condition allows access",
+			input: Input{Subject: &Subject{Roles: []string{"admin"}}},
+			rule:  &guard.Rule{Condition: guard.Ptr("'admin' in subject.roles")},
+			want:  &EvaluationResult{Allowed: true, Rule: RuleKindCondition},
+		},
+		{
+			name:  "condition denies access",
+			input: Input{Subject: &Subject{Roles: []string{"user"}}},
+			rule:  &guard.Rule{Condition: guard.Ptr("'admin' in subject.roles")},
+			want:  &EvaluationResult{Allowed: false, Rule: RuleKindCondition},
+		},
+		{
+			name:         "condition with invalid expression",
+			input:        Input{Subject: &Subject{}},
+			rule:         &guard.Rule{Condition: guard.Ptr("subject.roles ==")},
+			errAssertion: assert.Error,
+		},
 		{
 			name:  "policy based access with undefined policy",
 			input: Input{Subject: &Subject{}},
@@ -303,12 +633,175 @@ func Test_interceptor_evaluateRule(t *testing.T) {
 	}
 }
 
+func Test_interceptor_evaluateAuthenticatedAccess(t *testing.T) {
+	var (
+		adminRole = &guard.RoleBased{
+			Roles:       []string{"admin"},
+			Requirement: guard.RequirementAtLeastOne,
+		}
+		ownerPolicy = &guard.PolicyBased{
+			Policies:    []string{"owner"},
+			Requirement: guard.RequirementAtLeastOne,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		input    Input
+		access   *guard.AuthenticatedAccess
+		policies Policies
+
+		want         *EvaluationResult
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:   "no checks",
+			input:  Input{Subject: &Subject{}},
+			access: &guard.AuthenticatedAccess{Combine: guard.CombineAny},
+			want:   &EvaluationResult{Allowed: false, Rule: RuleKindPrivate},
+		},
+		{
+			name:     "all checks pass",
+			input:    Input{Subject: &Subject{Roles: []string{"admin"}}},
+			access:   &guard.AuthenticatedAccess{RoleBased: adminRole, PolicyBased: ownerPolicy},
+			policies: Policies{"owner": func(ctx context.Context, input *Input) (bool, error) { return true, nil }},
+			want: &EvaluationResult{
+				Allowed: true,
+				Rule:    RuleKindPolicyBased,
+				Details: []string{string(RuleKindRoleBased), string(RuleKindPolicyBased)},
+			},
+		},
+		{
+			name:     "all checks with failed policy",
+			input:    Input{Subject: &Subject{Roles: []string{"admin"}}},
+			access:   &guard.AuthenticatedAccess{RoleBased: adminRole, PolicyBased: ownerPolicy},
+			policies: Policies{"owner": func(ctx context.Context, input *Input) (bool, error) { return false, nil }},
+			want: &EvaluationResult{
+				Allowed: false,
+				Rule:    RuleKindPolicyBased,
+				Missing: map[RuleKind][]string{RuleKindPolicyBased: {"owner"}},
+			},
+		},
+		{
+			name:  "all checks with missing scopes",
+			input: Input{Subject: &Subject{Scopes: []string{"orders:read"}}},
+			access: &guard.AuthenticatedAccess{ScopeBased: &guard.ScopeBased{
+				Scopes:      []string{"orders:read", "orders:write", "orders:delete"},
+				Requirement: guard.RequirementAll,
+			}},
+			want: &EvaluationResult{
+				Allowed: false,
+				Rule:    RuleKindScopeBased,
+				Missing: map[RuleKind][]string{RuleKindScopeBased: {"orders:write", "orders:delete"}},
+			},
+		},
+		{
+			name:  "all checks with forbidden role",
+			input: Input{Subject: &Subject{Roles: []string{"suspended"}}},
+			access: &guard.AuthenticatedAccess{RoleBased: &guard.RoleBased{
+				Roles:       []string{"suspended", "banned"},
+				Requirement: guard.RequirementNone,
+			}},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindRoleBased},
+		},
+		{
+			name:  "any check with granting role skips policy",
+			input: Input{Subject: &Subject{Roles: []string{"admin"}}},
+			access: &guard.AuthenticatedAccess{
+				RoleBased:   adminRole,
+				PolicyBased: ownerPolicy,
+				Combine:     guard.CombineAny,
+			},
+			policies: Policies{"owner": func(ctx context.Context, input *Input) (bool, error) { return false, errors.New("error") }},
+			want:     &EvaluationResult{Allowed: true, Rule: RuleKindRoleBased, Details: []string{string(RuleKindRoleBased)}},
+		},
+		{
+			name:  "any check with granting policy",
+			input: Input{Subject: &Subject{Roles: []string{"user"}}},
+			access: &guard.AuthenticatedAccess{
+				RoleBased:   adminRole,
+				PolicyBased: ownerPolicy,
+				Combine:     guard.CombineAny,
+			},
+			policies: Policies{"owner": func(ctx context.Context, input *Input) (bool, error) { return true, nil }},
+			want:     &EvaluationResult{Allowed: true, Rule: RuleKindPolicyBased, Details: []string{string(RuleKindPolicyBased)}},
+		},
+		{
+			name:  "any check without granting checks",
+			input: Input{Subject: &Subject{Roles: []string{"user"}}},
+			access: &guard.AuthenticatedAccess{
+				RoleBased:   adminRole,
+				PolicyBased: ownerPolicy,
+				Combine:     guard.CombineAny,
+			},
+			policies: Policies{"owner": func(ctx context.Context, input *Input) (bool, error) { return false, nil }},
+			want: &EvaluationResult{
+				Allowed: false,
+				Rule:    RuleKindRoleBased,
+				Details: []string{string(RuleKindRoleBased), string(RuleKindPolicyBased)},
+				Missing: map[RuleKind][]string{
+					RuleKindRoleBased:   {"admin"},
+					RuleKindPolicyBased: {"owner"},
+				},
+			},
+		},
+		{
+			name:   "step-up requirements only",
+			input:  Input{Subject: &Subject{AuthLevel: 2}},
+			access: &guard.AuthenticatedAccess{MinAuthLevel: 2},
+			want:   &EvaluationResult{Allowed: true, Rule: RuleKindAuthLevel},
+		},
+		{
+			name:  "any check with granting role and insufficient auth level",
+			input: Input{Subject: &Subject{Roles: []string{"admin"}, AuthLevel: 1}},
+			access: &guard.AuthenticatedAccess{
+				RoleBased:    adminRole,
+				PolicyBased:  ownerPolicy,
+				Combine:      guard.CombineAny,
+				MinAuthLevel: 2,
+			},
+			want: &EvaluationResult{Allowed: false, Rule: RuleKindAuthLevel, Details: []string{"2"}},
+		},
+		{
+			name:  "any check with policy error",
+			input: Input{Subject: &Subject{Roles: []string{"user"}}},
+			access: &guard.AuthenticatedAccess{
+				RoleBased:   adminRole,
+				PolicyBased: ownerPolicy,
+				Combine:     guard.CombineAny,
+			},
+			policies:     Policies{"owner": func(ctx context.Context, input *Input) (bool, error) { return false, errors.New("error") }},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &Interceptor{
+				policies: tt.policies,
+			}
+
+			result, err := i.evaluateAuthenticatedAccess(context.Background(), tt.access, &tt.input)
+			if tt.errAssertion != nil {
+				tt.errAssertion(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
 func Test_interceptor_evaluateRoleBasedAccess(t *testing.T) {
 	tests := []struct {
-		name          string
-		requiredRoles []string
-		subjectRoles  []string
-		requirement   guard.Requirement
+		name             string
+		requiredRoles    []string
+		subjectRoles     []string
+		requirement      guard.Requirement
+		threshold        int
+		roleHierarchy    guard.RoleHierarchy
+		serviceHierarchy guard.RoleHierarchy
 
 		allowAssertion assert.BoolAssertionFunc
 		errAssertion   assert.ErrorAssertionFunc
@@ -351,6 +844,76 @@ func Test_interceptor_evaluateRoleBasedAccess(t *testing.T) {
 			requirement:    guard.RequirementAtLeastOne,
 			allowAssertion: assert.False,
 		},
+		{
+			name:           "requirement none of required roles",
+			requiredRoles:  []string{"suspended", "banned"},
+			subjectRoles:   []string{"user"},
+			requirement:    guard.RequirementNone,
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "no requirement none of required roles",
+			requiredRoles:  []string{"suspended", "banned"},
+			subjectRoles:   []string{"user", "banned"},
+			requirement:    guard.RequirementNone,
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "requirement at least threshold of required roles",
+			requiredRoles:  []string{"reviewer", "approver", "auditor"},
+			subjectRoles:   []string{"approver", "auditor"},
+			requirement:    guard.RequirementAtLeast,
+			threshold:      2,
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "no requirement at least threshold of required roles",
+			requiredRoles:  []string{"reviewer", "approver", "auditor"},
+			subjectRoles:   []string{"approver"},
+			requirement:    guard.RequirementAtLeast,
+			threshold:      2,
+			allowAssertion: assert.False,
+		},
+		{
+			name:          "at least requirement without threshold",
+			requiredRoles: []string{"admin"},
+			subjectRoles:  []string{"admin"},
+			requirement:   guard.RequirementAtLeast,
+			errAssertion:  assert.Error,
+		},
+		{
+			name:           "role implied transitively",
+			requiredRoles:  []string{"viewer"},
+			subjectRoles:   []string{"admin"},
+			requirement:    guard.RequirementAll,
+			roleHierarchy:  guard.RoleHierarchy{"admin": {"editor"}, "editor": {"viewer"}},
+			allowAssertion: assert.True,
+		},
+		{
+			name:             "role implied by service hierarchy",
+			requiredRoles:    []string{"viewer", "editor"},
+			subjectRoles:     []string{"admin"},
+			requirement:      guard.RequirementAll,
+			roleHierarchy:    guard.RoleHierarchy{"admin": {"editor"}},
+			serviceHierarchy: guard.RoleHierarchy{"editor": {"viewer"}},
+			allowAssertion:   assert.True,
+		},
+		{
+			name:           "lower role does not imply higher one",
+			requiredRoles:  []string{"admin"},
+			subjectRoles:   []string{"viewer"},
+			requirement:    guard.RequirementAtLeastOne,
+			roleHierarchy:  guard.RoleHierarchy{"admin": {"editor"}, "editor": {"viewer"}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "cyclic hierarchy",
+			requiredRoles:  []string{"viewer"},
+			subjectRoles:   []string{"admin"},
+			requirement:    guard.RequirementAll,
+			roleHierarchy:  guard.RoleHierarchy{"admin": {"editor"}, "editor": {"admin", "viewer"}},
+			allowAssertion: assert.True,
+		},
 		{
 			name:          "unknown requirement type",
 			requiredRoles: []string{"admin"},
@@ -362,20 +925,110 @@ func Test_interceptor_evaluateRoleBasedAccess(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			i := &Interceptor{}
+			i := &Interceptor{
+				roleHierarchy: tt.roleHierarchy,
+			}
 
 			roleBased := &guard.RoleBased{
 				Roles:       tt.requiredRoles,
 				Requirement: tt.requirement,
+				Threshold:   tt.threshold,
 			}
 
 			input := &Input{
 				Subject: &Subject{
 					Roles: tt.subjectRoles,
 				},
+				roleHierarchy: tt.serviceHierarchy,
+			}
+
+			allowed, _, err := i.evaluateRoleBasedAccess(context.Background(), roleBased, input)
+			if tt.errAssertion != nil {
+				tt.errAssertion(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.allowAssertion(t, allowed)
+		})
+	}
+}
+
+func Test_interceptor_evaluateScopeBasedAccess(t *testing.T) {
+	tests := []struct {
+		name           string
+		requiredScopes []string
+		subjectScopes  []string
+		requirement    guard.Requirement
+
+		allowAssertion assert.BoolAssertionFunc
+		errAssertion   assert.ErrorAssertionFunc
+	}{
+		{
+			name:           "nil required scopes",
+			requiredScopes: nil,
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "requirement all required scopes",
+			requiredScopes: []string{"orders:read", "orders:write"},
+			subjectScopes:  []string{"orders:write", "orders:read"},
+			requirement:    guard.RequirementAll,
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "no requirement all required scopes",
+			requiredScopes: []string{"orders:read", "orders:write"},
+			subjectScopes:  []string{"orders:read"},
+			requirement:    guard.RequirementAll,
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "requirement at least one required scopes",
+			requiredScopes: []string{"orders:read", "orders:write"},
+			subjectScopes:  []string{"orders:read"},
+			requirement:    guard.RequirementAtLeastOne,
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "no requirement at least one required scopes",
+			requiredScopes: []string{"orders:read"},
+			subjectScopes:  nil,
+			requirement:    guard.RequirementAtLeastOne,
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "requirement none of required scopes",
+			requiredScopes: []string{"admin:write"},
+			subjectScopes:  []string{"orders:read"},
+			requirement:    guard.RequirementNone,
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "unknown requirement type",
+			requiredScopes: []string{"orders:read"},
+			subjectScopes:  []string{"orders:read"},
+			requirement:    guard.Requirement(-1),
+			errAssertion:   assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &Interceptor{}
+
+			scopeBased := &guard.ScopeBased{
+				Scopes:      tt.requiredScopes,
+				Requirement: tt.requirement,
+			}
+
+			input := &Input{
+				Subject: &Subject{
+					Scopes: tt.subjectScopes,
+				},
 			}
 
-			allowed, err := i.evaluateRoleBasedAccess(context.Background(), roleBased, input)
+			allowed, _, err := i.evaluateScopeBasedAccess(context.Background(), scopeBased, input)
 			if tt.errAssertion != nil {
 				tt.errAssertion(t, err)
 				return
@@ -393,6 +1046,8 @@ func Test_interceptor_evaluatePolicyBasedAccess(t *testing.T) {
 		requiredPolicies []string
 		declaredPolicies Policies
 		requirement      guard.Requirement
+		threshold        int
+		args             map[string]guard.PolicyArgs
 
 		allowAssertion assert.BoolAssertionFunc
 		errAssertion   assert.ErrorAssertionFunc
@@ -470,6 +1125,77 @@ func Test_interceptor_evaluatePolicyBasedAccess(t *testing.T) {
 				return assert.ErrorIs(t, err, ErrUndefinedPolicy)
 			},
 		},
+		{
+			name:             "requirement none of required policies",
+			requiredPolicies: []string{"negative-policy-1", "negative-policy-2"},
+			declaredPolicies: Policies{
+				"negative-policy-1": func(ctx context.Context, input *Input) (bool, error) { return false, nil },
+				"negative-policy-2": func(ctx context.Context, input *Input) (bool, error) { return false, nil },
+			},
+			requirement:    guard.RequirementNone,
+			allowAssertion: assert.True,
+		},
+		{
+			name:             "no requirement none of required policies",
+			requiredPolicies: []string{"negative-policy-1", "positive-policy-1"},
+			declaredPolicies: Policies{
+				"negative-policy-1": func(ctx context.Context, input *Input) (bool, error) { return false, nil },
+				"positive-policy-1": func(ctx context.Context, input *Input) (bool, error) { return true, nil },
+			},
+			requirement:    guard.RequirementNone,
+			allowAssertion: assert.False,
+		},
+		{
+			name:             "requirement at least threshold of required policies",
+			requiredPolicies: []string{"positive-policy-1", "negative-policy-1", "positive-policy-2"},
+			declaredPolicies: Policies{
+				"positive-policy-1": func(ctx context.Context, input *Input) (bool, error) { return true, nil },
+				"negative-policy-1": func(ctx context.Context, input *Input) (bool, error) { return false, nil },
+				"positive-policy-2": func(ctx context.Context, input *Input) (bool, error) { return true, nil },
+			},
+			requirement:    guard.RequirementAtLeast,
+			threshold:      2,
+			allowAssertion: assert.True,
+		},
+		{
+			name:             "no requirement at least threshold of required policies",
+			requiredPolicies: []string{"positive-policy-1", "negative-policy-1", "negative-policy-2"},
+			declaredPolicies: Policies{
+				"positive-policy-1": func(ctx context.Context, input *Input) (bool, error) { return true, nil },
+				"negative-policy-1": func(ctx context.Context, input *Input) (bool, error) { return false, nil },
+				"negative-policy-2": func(ctx context.Context, input *Input) (bool, error) { return false, nil },
+			},
+			requirement:    guard.RequirementAtLeast,
+			threshold:      2,
+			allowAssertion: assert.False,
+		},
+		{
+			name:             "policy with arguments",
+			requiredPolicies: []string{"quota", "quota-free"},
+			declaredPolicies: Policies{
+				"quota": func(ctx context.Context, input *Input) (bool, error) {
+					return input.Args["limit"] == float64(10), nil
+				},
+				"quota-free": func(ctx context.Context, input *Input) (bool, error) {
+					return input.Args == nil, nil
+				},
+			},
+			requirement:    guard.RequirementAll,
+			args:           map[string]guard.PolicyArgs{"quota": {"limit": float64(10)}},
+			allowAssertion: assert.True,
+		},
+		{
+			name:             "same policy with other arguments",
+			requiredPolicies: []string{"quota"},
+			declaredPolicies: Policies{
+				"quota": func(ctx context.Context, input *Input) (bool, error) {
+					return input.Args["limit"] == float64(10), nil
+				},
+			},
+			requirement:    guard.RequirementAll,
+			args:           map[string]guard.PolicyArgs{"quota": {"limit": float64(100)}},
+			allowAssertion: assert.False,
+		},
 		{
 			name:             "error policy",
 			requiredPolicies: []string{"positive-1", "positive-2"},
@@ -491,9 +1217,11 @@ func Test_interceptor_evaluatePolicyBasedAccess(t *testing.T) {
 			policyBased := &guard.PolicyBased{
 				Policies:    tt.requiredPolicies,
 				Requirement: tt.requirement,
+				Threshold:   tt.threshold,
+				Args:        tt.args,
 			}
 
-			allowed, err := i.evaluatePolicyBasedAccess(context.Background(), policyBased, &Input{})
+			allowed, _, err := i.evaluatePolicyBasedAccess(context.Background(), policyBased, &Input{})
 			if tt.errAssertion != nil {
 				tt.errAssertion(t, err)
 				return
@@ -504,3 +1232,222 @@ func Test_interceptor_evaluatePolicyBasedAccess(t *testing.T) {
 		})
 	}
 }
+
+func Test_interceptor_evaluateCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		expression string
+		input      Input
+
+		allowAssertion assert.BoolAssertionFunc
+		errAssertion   assert.ErrorAssertionFunc
+	}{
+		{
+			name:           "subject attribute matches request field",
+			ctx:            context.Background(),
+			expression:     "subject.attrs.tenant_id == request.name",
+			input:          Input{Request: &apipb.Api{Name: "tenant-1"}, Subject: &Subject{Attrs: map[string]any{"tenant_id": "tenant-1"}}},
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "subject attribute does not match request field",
+			ctx:            context.Background(),
+			expression:     "subject.attrs.tenant_id == request.name",
+			input:          Input{Request: &apipb.Api{Name: "tenant-2"}, Subject: &Subject{Attrs: map[string]any{"tenant_id": "tenant-1"}}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "metadata value matches",
+			ctx:            metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-client-app", "ios")),
+			expression:     "'ios' in metadata['x-client-app']",
+			input:          Input{Subject: &Subject{}},
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "authentication scheme matches",
+			ctx:            context.Background(),
+			expression:     "subject.scheme == 'mtls'",
+			input:          Input{Subject: &Subject{Scheme: "mtls"}},
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "missing metadata",
+			ctx:            context.Background(),
+			expression:     "'x-client-app' in metadata",
+			input:          Input{Subject: &Subject{}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:         "unknown request field",
+			ctx:          context.Background(),
+			expression:   "request.unknown == 'value'",
+			input:        Input{Request: &apipb.Api{Name: "value"}, Subject: &Subject{}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:           "missing subject attribute",
+			ctx:            context.Background(),
+			expression:     "subject.attrs.tenant_id == request.name",
+			input:          Input{Request: &apipb.Api{Name: "tenant-1"}, Subject: &Subject{}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "missing metadata key",
+			ctx:            context.Background(),
+			expression:     "metadata['x-client-app'][0] == 'ios'",
+			input:          Input{Subject: &Subject{}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:         "runtime fault",
+			ctx:          context.Background(),
+			expression:   "1 / size(subject.roles) == 1",
+			input:        Input{Subject: &Subject{}},
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "non-bool result",
+			ctx:          context.Background(),
+			expression:   "subject.roles",
+			input:        Input{Subject: &Subject{}},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &Interceptor{}
+
+			allowed, err := i.evaluateCondition(tt.ctx, tt.expression, &tt.input)
+			if tt.errAssertion != nil {
+				tt.errAssertion(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.allowAssertion(t, allowed)
+		})
+	}
+}
+
+func Test_evaluateOwnership(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownership *guard.Ownership
+		input     Input
+
+		allowAssertion assert.BoolAssertionFunc
+	}{
+		{
+			name:           "request field equals subject attribute",
+			ownership:      testOwnership(),
+			input:          Input{Request: &apipb.Api{Name: "user-1"}, Subject: &Subject{Attrs: map[string]any{"id": "user-1"}}},
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "string field does not match integer attribute",
+			ownership:      testOwnership(),
+			input:          Input{Request: &apipb.Api{Name: "42"}, Subject: &Subject{Attrs: map[string]any{"id": 42}}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "integer field equals integer attribute",
+			ownership:      testIntegerOwnership(),
+			input:          Input{Request: wrapperspb.Int64(100000000), Subject: &Subject{Attrs: map[string]any{"id": int64(100000000)}}},
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "integer field equals integer attribute of another type",
+			ownership:      testIntegerOwnership(),
+			input:          Input{Request: wrapperspb.Int64(42), Subject: &Subject{Attrs: map[string]any{"id": uint32(42)}}},
+			allowAssertion: assert.True,
+		},
+		{
+			name:           "integer field differs from integer attribute",
+			ownership:      testIntegerOwnership(),
+			input:          Input{Request: wrapperspb.Int64(42), Subject: &Subject{Attrs: map[string]any{"id": int64(7)}}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "integer field does not match string attribute",
+			ownership:      testIntegerOwnership(),
+			input:          Input{Request: wrapperspb.Int64(42), Subject: &Subject{Attrs: map[string]any{"id": "42"}}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "integer field does not match float attribute",
+			ownership:      testIntegerOwnership(),
+			input:          Input{Request: wrapperspb.Int64(100000000), Subject: &Subject{Attrs: map[string]any{"id": float64(100000000)}}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "request field differs from subject attribute",
+			ownership:      testOwnership(),
+			input:          Input{Request: &apipb.Api{Name: "user-2"}, Subject: &Subject{Attrs: map[string]any{"id": "user-1"}}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "empty request field",
+			ownership:      testOwnership(),
+			input:          Input{Request: &apipb.Api{}, Subject: &Subject{Attrs: map[string]any{"id": ""}}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "missing subject attribute",
+			ownership:      testOwnership(),
+			input:          Input{Request: &apipb.Api{Name: "user-1"}, Subject: &Subject{}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "unexpected request type",
+			ownership:      testOwnership(),
+			input:          Input{Request: "user-1", Subject: &Subject{Attrs: map[string]any{"id": "user-1"}}},
+			allowAssertion: assert.False,
+		},
+		{
+			name:           "without request accessor",
+			ownership:      &guard.Ownership{RequestField: "name", SubjectAttr: "id"},
+			input:          Input{Request: &apipb.Api{Name: "user-1"}, Subject: &Subject{Attrs: map[string]any{"id": "user-1"}}},
+			allowAssertion: assert.False,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.allowAssertion(t, evaluateOwnership(tt.ownership, &tt.input))
+		})
+	}
+}
+
+// testOwnership returns an ownership rule comparing apipb.Api name with the "id" subject attribute,
+// with the accessor the plugin would generate for it.
+func testOwnership() *guard.Ownership {
+	return &guard.Ownership{
+		RequestField: "name",
+		SubjectAttr:  "id",
+		RequestValue: func(request any) (any, bool) {
+			switch r := request.(type) {
+			case *apipb.Api:
+				return r.GetName(), true
+			}
+			return nil, false
+		},
+	}
+}
+
+// testIntegerOwnership returns an ownership rule comparing wrapperspb.Int64Value value with the "id" subject attribute,
+// with the accessor the plugin would generate for it.
+func testIntegerOwnership() *guard.Ownership {
+	return &guard.Ownership{
+		RequestField: "value",
+		SubjectAttr:  "id",
+		RequestValue: func(request any) (any, bool) {
+			switch r := request.(type) {
+			case *wrapperspb.Int64Value:
+				return r.GetValue(), true
+			}
+			return nil, false
+		},
+	}
+}
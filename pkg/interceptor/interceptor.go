@@ -9,9 +9,14 @@ package interceptor
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"log"
+	"net/netip"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
 	"google.golang.org/grpc"
@@ -21,22 +26,40 @@ import (
 
 type (
 	// Subject represents the authenticated principal making the request.
-	// It carries identity attributes such as roles and arbitrary custom data.
+	// It carries identity attributes such as roles and arbitrary custom data,
+	// OAuth2 scopes granted to the client application, how strongly and when
+	// the principal authenticated, checked by step-up rules, and by which scheme.
 	Subject struct {
-		Roles []string
-		Attrs map[string]any
+		Roles           []string
+		Scopes          []string
+		Attrs           map[string]any
+		AuthLevel       int       // Authentication level, e.g. 1 for password and 2 for MFA (ACR-like, higher is stronger).
+		AuthenticatedAt time.Time // Time of the authentication (zero if unknown).
+		Scheme          string    // Name of the authentication scheme, set by ChainResolvers (empty if unknown).
 	}
 
 	// SubjectResolver is a function that extracts a Subject from the request context.
 	// If the user is unauthenticated, it should return (nil, nil).
-	// Any error returned will cause the interceptor to reject the request with an internal error.
+	// Errors wrapping ErrInvalidCredentials, e.g. for expired tokens, cause the interceptor
+	// to reject the request as unauthenticated, and any other error — with an internal error.
 	SubjectResolver func(ctx context.Context) (*Subject, error)
+	// Authenticators is a registry of named subject resolvers referenced
+	// by authentication options of services and methods in .proto files.
+	Authenticators map[string]SubjectResolver
 )
 
+// ErrInvalidCredentials is wrapped by subject resolver errors for credentials that are present but not valid.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
 // Input encapsulates the data available during rule evaluation.
 type Input struct {
-	Request any      // The original gRPC request message (nil for streaming calls).
-	Subject *Subject // The resolved subject (nil if unauthenticated).
+	Request     any               // The original gRPC request message (nil for streaming calls).
+	Subject     *Subject          // The resolved subject (nil if unauthenticated).
+	Args        guard.PolicyArgs  // Arguments declared in proto for the policy being evaluated (nil otherwise).
+	Peer        netip.Addr        // The client address (invalid if unknown), see WithTrustedProxies.
+	Certificate *x509.Certificate // The verified mTLS client certificate of the calling service (nil without one).
+
+	roleHierarchy guard.RoleHierarchy // Role hierarchy declared in proto for the called service.
 }
 
 // Authenticated returns true if the request is associated with an authenticated subject.
@@ -47,6 +70,7 @@ func (i *Input) Authenticated() bool {
 type (
 	// Policy is a function that evaluates a custom authorization condition.
 	// It receives the current context and input, and returns whether the policy allows access.
+	// Arguments declared for the policy in proto are available in Input.Args.
 	// Any error returned will cause the interceptor to reject the request with an internal error.
 	Policy func(ctx context.Context, input *Input) (bool, error)
 	// Policies is a registry of named policy functions referenced in .proto guard rules.
@@ -57,18 +81,34 @@ type (
 type RuleKind string
 
 const (
-	RuleKindPublic        RuleKind = "public"
-	RuleKindAuthenticated RuleKind = "authenticated"
-	RuleKindRoleBased     RuleKind = "role-based"
-	RuleKindPolicyBased   RuleKind = "policy-based"
-	RuleKindPrivate       RuleKind = "private"
+	RuleKindPublic         RuleKind = "public"
+	RuleKindAuthenticated  RuleKind = "authenticated"
+	RuleKindAuthLevel      RuleKind = "auth-level"
+	RuleKindAuthAge        RuleKind = "auth-age"
+	RuleKindRoleBased      RuleKind = "role-based"
+	RuleKindScopeBased     RuleKind = "scope-based"
+	RuleKindPolicyBased    RuleKind = "policy-based"
+	RuleKindOwnership      RuleKind = "ownership"
+	RuleKindCondition      RuleKind = "condition"
+	RuleKindWriteProtected RuleKind = "write-protected"
+	RuleKindDeny           RuleKind = "deny"
+	RuleKindTimeWindow     RuleKind = "time-window"
+	RuleKindNetwork        RuleKind = "network"
+	RuleKindMetadata       RuleKind = "metadata"
+	RuleKindWorkload       RuleKind = "workload"
+	RuleKindPrivate        RuleKind = "private"
 )
 
 // EvaluationResult is the result of access rule evaluation.
+// Denial is the status declared by the rule that denied access, if any.
+// Missing lists, by check kind, the roles or scopes the subject lacks
+// and the policies that did not pass when access is denied.
 type EvaluationResult struct {
 	Allowed bool
 	Rule    RuleKind
 	Details []string
+	Denial  *guard.Denial
+	Missing map[RuleKind][]string
 }
 
 func (e EvaluationResult) String() string {
@@ -95,16 +135,27 @@ type (
 type Interceptor struct {
 	debug           bool
 	policies        Policies
+	roleHierarchy   guard.RoleHierarchy
 	defaultRules    guard.Rules
 	eventHandlers   EventHandlers
 	subjectResolver SubjectResolver
+	authenticators  Authenticators
+	clock           func() time.Time
+	errorDomain     string
+	trustedProxies  []netip.Prefix
+
+	conditions sync.Map // conditionKey -> cel.Program
+	locations  sync.Map // time zone name -> *time.Location
 }
 
 // New creates a new guard interceptor.
 // It requires a SubjectResolver and accepts optional configuration via Options.
+// The resolver is used for methods that accept no particular authenticators, see WithAuthenticators;
+// it may be nil if every method does, in which case other requests are unauthenticated.
 func New(resolver SubjectResolver, opts ...Option) *Interceptor {
 	i := Interceptor{
 		subjectResolver: resolver,
+		errorDomain:     DefaultErrorDomain,
 	}
 
 	for _, opt := range opts {
@@ -115,13 +166,20 @@ func New(resolver SubjectResolver, opts ...Option) *Interceptor {
 }
 
 // authorize evaluates whether the current request is allowed based on the resolved subject
-// and the applicable access rules. Returns nil on success, or a gRPC error on denial/failure.
-func (i *Interceptor) authorize(ctx context.Context, server any, fullMethod string, req any) error {
+// and the applicable access rules. Returns the evaluation input and the result allowing access on success,
+// or a gRPC error on denial/failure.
+func (i *Interceptor) authorize(ctx context.Context, server any, fullMethod string, req any) (*Input, *EvaluationResult, error) {
 	input := Input{
-		Request: req,
+		Request:     req,
+		Peer:        i.peerAddress(ctx),
+		Certificate: peerCertificate(ctx),
 	}
 
-	subject, err := i.subjectResolver(ctx)
+	if service := i.getGuardService(server); service != nil {
+		input.roleHierarchy = service.RoleHierarchy
+	}
+
+	subject, err := i.resolveSubject(ctx, server, fullMethod)
 	if err != nil {
 		if i.debug {
 			log.Printf("Failed to resolve subject for %s: %v", fullMethod, err)
@@ -131,14 +189,16 @@ func (i *Interceptor) authorize(ctx context.Context, server any, fullMethod stri
 			i.eventHandlers.OnError(ctx, &input, err)
 		}
 
-		return status.Error(codes.Internal, "failed to resolve subject")
+		return nil, nil, subjectError(err)
 	}
 
 	input.Subject = subject
 
-	rules := i.getRules(server, fullMethod)
+	result, err := i.evaluateDenyRules(ctx, i.getDenyRules(server, fullMethod), &input)
+	if err == nil && result == nil {
+		result, err = i.evaluateRuleGroups(ctx, i.getRules(server, fullMethod), &input)
+	}
 
-	result, err := i.evaluateRules(ctx, rules, &input)
 	if err != nil {
 		if i.debug {
 			log.Printf("Evaluation error for %s: %v", fullMethod, err)
@@ -148,7 +208,7 @@ func (i *Interceptor) authorize(ctx context.Context, server any, fullMethod stri
 			i.eventHandlers.OnError(ctx, &input, err)
 		}
 
-		return status.Error(codes.Internal, "evaluation error")
+		return nil, nil, status.Error(codes.Internal, "evaluation error")
 	}
 
 	if !result.Allowed {
@@ -160,38 +220,109 @@ func (i *Interceptor) authorize(ctx context.Context, server any, fullMethod stri
 			i.eventHandlers.OnAccessDenied(ctx, &input, result)
 		}
 
-		if result.Rule == RuleKindAuthenticated {
-			return status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
-		}
-
-		return status.Error(codes.PermissionDenied, codes.PermissionDenied.String())
+		return nil, nil, denialError(result, i.getDenial(server, fullMethod), i.errorDomain)
 	}
 
 	if i.debug {
 		log.Printf("Access granted for %s: %s", fullMethod, result.String())
 	}
 
-	return nil
+	return &input, result, nil
+}
+
+// subjectError builds the gRPC error for a failed subject resolution.
+func subjectError(err error) error {
+	if errors.Is(err, ErrInvalidCredentials) {
+		return status.Error(codes.Unauthenticated, ErrInvalidCredentials.Error())
+	}
+
+	return status.Error(codes.Internal, "failed to resolve subject")
+}
+
+// denialError builds the gRPC error for a denied result.
+// The denial declared by the denying rule takes precedence over the method denial;
+// without either, unauthenticated subjects and subjects required to step up authentication
+// get Unauthenticated and others PermissionDenied.
+// Error details describing the denial are attached unless the denial overrides the status code,
+// so that e.g. NotFound denials do not reveal why access was denied.
+func denialError(result *EvaluationResult, methodDenial *guard.Denial, domain string) error {
+	code := codes.PermissionDenied
+	if result.Rule == RuleKindAuthenticated || result.Rule == RuleKindAuthLevel || result.Rule == RuleKindAuthAge {
+		code = codes.Unauthenticated
+	}
+
+	denial := result.Denial
+	if denial == nil {
+		denial = methodDenial
+	}
+
+	if denial == nil {
+		denial = &guard.Denial{}
+	}
+
+	if denial.Code != 0 {
+		code = codes.Code(denial.Code)
+	}
+
+	message := denial.Message
+	if message == "" {
+		message = code.String()
+	}
+
+	st := status.New(code, message)
+	if denial.Code != 0 {
+		return st.Err()
+	}
+
+	return deniedStatus(st, result, domain).Err()
 }
 
 // Unary returns a grpc.UnaryServerInterceptor that enforces guard rules
 // on unary (request-response) gRPC methods.
+// Requests setting fields the subject is not allowed to write are rejected,
+// and response fields the subject is not allowed to see are cleared from a copy of the response.
+// The handler context carries the subject and the evaluation result, see SubjectFromContext and DecisionFromContext.
 func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-		if err = i.authorize(ctx, info.Server, info.FullMethod, req); err != nil {
+		input, result, err := i.authorize(ctx, info.Server, info.FullMethod, req)
+		if err != nil {
 			return nil, err
 		}
-		return handler(ctx, req)
+
+		ctx = contextWithAuthorization(ctx, input.Subject, result)
+
+		if err = i.checkWriteRules(ctx, info.Server, info.FullMethod, req, input); err != nil {
+			return nil, err
+		}
+
+		resp, err = handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return i.redactResponse(ctx, info.Server, info.FullMethod, resp, input)
 	}
 }
 
 // Stream returns a grpc.StreamServerInterceptor that enforces guard rules
 // on streaming gRPC methods.
+// Response fields the subject is not allowed to see are cleared from every sent message,
+// and received messages setting fields the subject is not allowed to write are rejected.
+// The stream context carries the subject and the evaluation result, see SubjectFromContext and DecisionFromContext.
 func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
 	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		if err := i.authorize(ss.Context(), srv, info.FullMethod, nil); err != nil {
+		input, result, err := i.authorize(ss.Context(), srv, info.FullMethod, nil)
+		if err != nil {
 			return err
 		}
-		return handler(srv, ss)
+
+		return handler(srv, &authorizedServerStream{
+			ServerStream: ss,
+			ctx:          contextWithAuthorization(ss.Context(), input.Subject, result),
+			interceptor:  i,
+			server:       srv,
+			fullMethod:   info.FullMethod,
+			input:        input,
+		})
 	}
 }
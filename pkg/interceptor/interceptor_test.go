@@ -1,7 +1,16 @@
 package interceptor
 
 import (
+	"errors"
+	"fmt"
+	"testing"
+
 	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockGuardServiceProvider struct {
@@ -11,3 +20,106 @@ type mockGuardServiceProvider struct {
 func (m mockGuardServiceProvider) GuardService() *guard.Service {
 	return m.service
 }
+
+func Test_denialError(t *testing.T) {
+	tests := []struct {
+		name         string
+		result       *EvaluationResult
+		methodDenial *guard.Denial
+		wantCode     codes.Code
+		wantMessage  string
+		wantReason   string
+	}{
+		{
+			name:        "unauthenticated without denial",
+			result:      &EvaluationResult{Rule: RuleKindAuthenticated},
+			wantCode:    codes.Unauthenticated,
+			wantMessage: codes.Unauthenticated.String(),
+			wantReason:  ReasonUnauthenticated,
+		},
+		{
+			name:        "permission denied without denial",
+			result:      &EvaluationResult{Rule: RuleKindRoleBased},
+			wantCode:    codes.PermissionDenied,
+			wantMessage: codes.PermissionDenied.String(),
+			wantReason:  ReasonMissingRole,
+		},
+		{
+			name:        "step-up required without denial",
+			result:      &EvaluationResult{Rule: RuleKindAuthAge, Details: []string{"300"}},
+			wantCode:    codes.Unauthenticated,
+			wantMessage: codes.Unauthenticated.String(),
+			wantReason:  ReasonAuthTooOld,
+		},
+		{
+			name:         "method denial",
+			result:       &EvaluationResult{Rule: RuleKindAuthenticated},
+			methodDenial: &guard.Denial{Code: uint32(codes.NotFound)},
+			wantCode:     codes.NotFound,
+			wantMessage:  codes.NotFound.String(),
+		},
+		{
+			name:         "rule denial takes precedence over method denial",
+			result:       &EvaluationResult{Rule: RuleKindRoleBased, Denial: &guard.Denial{Message: "upgrade required"}},
+			methodDenial: &guard.Denial{Code: uint32(codes.NotFound)},
+			wantCode:     codes.PermissionDenied,
+			wantMessage:  "upgrade required",
+			wantReason:   ReasonMissingRole,
+		},
+		{
+			name:        "rule denial with code and message",
+			result:      &EvaluationResult{Rule: RuleKindDeny, Denial: &guard.Denial{Code: uint32(codes.FailedPrecondition), Message: "account suspended"}},
+			wantCode:    codes.FailedPrecondition,
+			wantMessage: "account suspended",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(denialError(tt.result, tt.methodDenial, DefaultErrorDomain))
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Equal(t, tt.wantMessage, st.Message())
+
+			if tt.wantReason == "" {
+				assert.Empty(t, st.Details())
+				return
+			}
+
+			require.NotEmpty(t, st.Details())
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantReason, info.GetReason())
+			assert.Equal(t, DefaultErrorDomain, info.GetDomain())
+		})
+	}
+}
+
+func Test_subjectError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+	}{
+		{
+			name:        "invalid credentials",
+			err:         fmt.Errorf("%w: token expired", ErrInvalidCredentials),
+			wantCode:    codes.Unauthenticated,
+			wantMessage: "invalid credentials",
+		},
+		{
+			name:        "resolver failure",
+			err:         errors.New("key store unavailable"),
+			wantCode:    codes.Internal,
+			wantMessage: "failed to resolve subject",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(subjectError(tt.err))
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Equal(t, tt.wantMessage, st.Message())
+		})
+	}
+}
@@ -0,0 +1,358 @@
+// Package jwtresolver provides an interceptor.SubjectResolver validating JWT bearer tokens
+// from the "authorization" metadata.
+//
+// Tokens must be signed with HS256, RS256, ES256 or EdDSA by one of the keys of a KeySet,
+// either static or read from a JWKS file reloaded on change. The signing algorithm is defined
+// by the type of the verifying key, never by the token alone. Tokens must carry the "exp" claim;
+// "nbf", "iss" and "aud" are checked when present or configured.
+//
+// Requests without a bearer token resolve to a nil subject, so rules treat them as unauthenticated.
+// Invalid tokens are rejected with errors wrapping interceptor.ErrInvalidCredentials and ErrInvalidToken.
+package jwtresolver
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/internal/jsonvalue"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// DefaultClockSkew is the default tolerance of token lifetime checks.
+	DefaultClockSkew = 30 * time.Second
+	// DefaultRolesClaim is the default claim holding subject roles.
+	DefaultRolesClaim = "roles"
+	// DefaultScopesClaim is the default claim holding granted OAuth2 scopes.
+	DefaultScopesClaim = "scope"
+
+	authorizationKey = "authorization"
+	bearerPrefix     = "bearer "
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type resolver struct {
+	keys        KeySet
+	issuer      string
+	audience    string
+	clockSkew   time.Duration
+	clock       func() time.Time
+	rolesClaim  string
+	scopesClaim string
+	attrClaims  map[string]string
+	authLevels  map[string]int
+}
+
+// header is the JOSE header of a token.
+type header struct {
+	Alg  string   `json:"alg"`
+	Kid  string   `json:"kid"`
+	Crit []string `json:"crit"`
+}
+
+// New returns a SubjectResolver verifying bearer tokens with the keys of the set
+// and mapping their claims to the subject:
+//   - roles and scopes — from the configured claims, lists of strings or space-separated strings;
+//   - attributes — from the configured claims, see WithAttrClaims;
+//   - authentication level — from the "acr" claim, see WithAuthLevels;
+//   - authentication time — from the "auth_time" claim.
+func New(keys KeySet, opts ...Option) interceptor.SubjectResolver {
+	r := &resolver{
+		keys:        keys,
+		clockSkew:   DefaultClockSkew,
+		clock:       time.Now,
+		rolesClaim:  DefaultRolesClaim,
+		scopesClaim: DefaultScopesClaim,
+		attrClaims:  map[string]string{"sub": "sub"},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r.resolve
+}
+
+func (r *resolver) resolve(ctx context.Context) (*interceptor.Subject, error) {
+	token, found := bearerToken(ctx)
+	if !found {
+		return nil, nil
+	}
+
+	claims, err := r.verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.validate(claims); err != nil {
+		return nil, err
+	}
+
+	return r.subject(claims), nil
+}
+
+// bearerToken returns the token of the first "authorization" metadata value with the Bearer scheme.
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for _, value := range md.Get(authorizationKey) {
+		if len(value) >= len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(value[len(bearerPrefix):]), true
+		}
+	}
+
+	return "", false
+}
+
+// verify checks the token signature against the keys of the set and returns the token claims.
+func (r *resolver) verify(token string) (map[string]any, error) {
+	encodedHeader, rest, _ := strings.Cut(token, ".")
+	encodedClaims, encodedSignature, found := strings.Cut(rest, ".")
+	if !found || strings.Contains(encodedSignature, ".") {
+		return nil, invalidToken("malformed token")
+	}
+
+	var h header
+	if err := decodeSegment(encodedHeader, &h); err != nil {
+		return nil, invalidToken("header: %v", err)
+	}
+
+	if len(h.Crit) > 0 {
+		return nil, invalidToken("unsupported critical headers %v", h.Crit)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, invalidToken("signature: %v", err)
+	}
+
+	keys, err := r.keys.Keys()
+	if err != nil {
+		return nil, fmt.Errorf("keys: %w", err)
+	}
+
+	signed := []byte(token[:len(encodedHeader)+1+len(encodedClaims)])
+
+	verified := slices.ContainsFunc(keys, func(key Key) bool {
+		if h.Kid != "" && key.ID != "" && h.Kid != key.ID {
+			return false
+		}
+
+		return algorithm(key.Key) == h.Alg && verifySignature(key.Key, signed, signature)
+	})
+
+	if !verified {
+		return nil, invalidToken("no key verifies %s signature", h.Alg)
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(encodedClaims, &claims); err != nil {
+		return nil, invalidToken("claims: %v", err)
+	}
+
+	return claims, nil
+}
+
+// verifySignature checks the signature of the data with the key of a supported type.
+func verifySignature(key any, data, signature []byte) bool {
+	switch key := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write(data)
+
+		return hmac.Equal(mac.Sum(nil), signature)
+
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+
+	case *ecdsa.PublicKey:
+		// JWS ECDSA signatures are R and S concatenated, not ASN.1 encoded.
+		if len(signature) != 64 {
+			return false
+		}
+
+		digest := sha256.Sum256(data)
+		rs, ss := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+
+		return ecdsa.Verify(key, digest[:], rs, ss)
+
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, data, signature)
+
+	default:
+		return false
+	}
+}
+
+// validate checks registered claims: lifetime with clock skew, issuer and audience.
+func (r *resolver) validate(claims map[string]any) error {
+	now := r.clock()
+
+	expiresAt, found, err := numericDate(claims, "exp")
+	switch {
+	case err != nil:
+		return err
+	case !found:
+		return invalidToken("missing exp claim")
+	case !now.Before(expiresAt.Add(r.clockSkew)):
+		return invalidToken("token expired")
+	}
+
+	notBefore, found, err := numericDate(claims, "nbf")
+	switch {
+	case err != nil:
+		return err
+	case found && now.Add(r.clockSkew).Before(notBefore):
+		return invalidToken("token not valid yet")
+	}
+
+	if r.issuer != "" {
+		if issuer, _ := claims["iss"].(string); issuer != r.issuer {
+			return invalidToken("unexpected issuer %q", issuer)
+		}
+	}
+
+	if r.audience != "" && !hasAudience(claims["aud"], r.audience) {
+		return invalidToken("audience %q not accepted", r.audience)
+	}
+
+	return nil
+}
+
+// hasAudience reports whether the "aud" claim contains the audience.
+// A string claim is a single audience, so unlike scopes it is never split on spaces.
+func hasAudience(claim any, audience string) bool {
+	if value, ok := claim.(string); ok {
+		return value == audience
+	}
+
+	return slices.Contains(stringList(claim), audience)
+}
+
+// subject maps the claims to the subject. Numbers in attributes become int64 or float64, see jsonvalue.Plain.
+func (r *resolver) subject(claims map[string]any) *interceptor.Subject {
+	subject := &interceptor.Subject{
+		Roles:  stringList(lookupClaim(claims, r.rolesClaim)),
+		Scopes: stringList(lookupClaim(claims, r.scopesClaim)),
+	}
+
+	for attr, claim := range r.attrClaims {
+		if value := lookupClaim(claims, claim); value != nil {
+			if subject.Attrs == nil {
+				subject.Attrs = make(map[string]any, len(r.attrClaims))
+			}
+
+			subject.Attrs[attr] = jsonvalue.Plain(value)
+		}
+	}
+
+	if acr, ok := claims["acr"].(string); ok {
+		subject.AuthLevel = r.authLevels[acr]
+	}
+
+	if authTime, found, err := numericDate(claims, "auth_time"); found && err == nil {
+		subject.AuthenticatedAt = authTime
+	}
+
+	return subject
+}
+
+// lookupClaim returns the value of a dot-separated claim path, or nil if there is none.
+func lookupClaim(claims map[string]any, path string) any {
+	if path == "" {
+		return nil
+	}
+
+	var value any = claims
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		value = object[name]
+	}
+
+	return value
+}
+
+// stringList converts a claim holding a list of strings or a space-separated string.
+// Non-string list items are skipped.
+func stringList(value any) []string {
+	switch value := value.(type) {
+	case string:
+		return strings.Fields(value)
+	case []any:
+		list := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+
+		return list
+	default:
+		return nil
+	}
+}
+
+// numericDate returns the time of a NumericDate claim, seconds since the epoch.
+func numericDate(claims map[string]any, name string) (time.Time, bool, error) {
+	value, exists := claims[name]
+	if !exists {
+		return time.Time{}, false, nil
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false, invalidToken("%s claim is not a number", name)
+	}
+
+	seconds, err := number.Float64()
+	if err != nil || math.IsInf(seconds, 0) {
+		return time.Time{}, false, invalidToken("%s claim is not a number", name)
+	}
+
+	whole, fraction := math.Modf(seconds)
+
+	return time.Unix(int64(whole), int64(fraction*1e9)), true, nil
+}
+
+// decodeSegment decodes a base64url-encoded JSON token segment, keeping numbers as json.Number.
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+func invalidToken(format string, args ...any) error {
+	return fmt.Errorf("%w: %w: %s", interceptor.ErrInvalidCredentials, ErrInvalidToken, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,339 @@
+package jwtresolver
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/interceptor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+var testNow = time.Date(2025, time.March, 5, 10, 30, 0, 0, time.UTC)
+
+// testSigner signs tokens with a private key, or a shared secret for HS256.
+type testSigner struct {
+	alg string
+	kid string
+	key any
+}
+
+func (s testSigner) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	header := map[string]any{"alg": s.alg, "typ": "JWT"}
+	if s.kid != "" {
+		header["kid"] = s.kid
+	}
+
+	signed := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+
+	var (
+		signature []byte
+		err       error
+	)
+
+	switch key := s.key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		r, s, signErr := ecdsa.Sign(rand.Reader, key, digest[:])
+		err = signErr
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, []byte(signed))
+	}
+
+	require.NoError(t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeSegment(t *testing.T, v any) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func contextWithAuthorization(values ...string) context.Context {
+	md := metadata.MD{}
+	md.Append("authorization", values...)
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func Test_resolver(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keys, err := StaticKeys(
+		Key{ID: "hs", Key: secret},
+		Key{ID: "rs", Key: &rsaKey.PublicKey},
+		Key{ID: "es", Key: &ecKey.PublicKey},
+		Key{ID: "ed", Key: edPublic},
+	)
+	require.NoError(t, err)
+
+	var (
+		hs = testSigner{alg: AlgorithmHS256, kid: "hs", key: secret}
+		rs = testSigner{alg: AlgorithmRS256, kid: "rs", key: rsaKey}
+		es = testSigner{alg: AlgorithmES256, kid: "es", key: ecKey}
+		ed = testSigner{alg: AlgorithmEdDSA, kid: "ed", key: edPrivate}
+	)
+
+	valid := func(extra map[string]any) map[string]any {
+		claims := map[string]any{
+			"iss": "https://auth.example.com",
+			"aud": []string{"orders", "billing"},
+			"sub": "user-1",
+			"exp": testNow.Add(time.Hour).Unix(),
+		}
+
+		for name, value := range extra {
+			claims[name] = value
+		}
+
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		opts    []Option
+		want    *interceptor.Subject
+		wantErr bool
+	}{
+		{
+			name: "no metadata",
+			ctx:  context.Background(),
+			want: nil,
+		},
+		{
+			name: "other authorization scheme",
+			ctx:  contextWithAuthorization("Basic dXNlcjpwYXNz"),
+			want: nil,
+		},
+		{
+			name: "hs256 token",
+			ctx:  contextWithAuthorization("Bearer " + hs.sign(t, valid(nil))),
+			want: &interceptor.Subject{Attrs: map[string]any{"sub": "user-1"}},
+		},
+		{
+			name: "rs256 token with lowercase scheme",
+			ctx:  contextWithAuthorization("bearer " + rs.sign(t, valid(nil))),
+			want: &interceptor.Subject{Attrs: map[string]any{"sub": "user-1"}},
+		},
+		{
+			name: "es256 token",
+			ctx:  contextWithAuthorization("Bearer " + es.sign(t, valid(nil))),
+			want: &interceptor.Subject{Attrs: map[string]any{"sub": "user-1"}},
+		},
+		{
+			name: "eddsa token without key id",
+			ctx:  contextWithAuthorization("Bearer " + testSigner{alg: AlgorithmEdDSA, key: edPrivate}.sign(t, valid(nil))),
+			want: &interceptor.Subject{Attrs: map[string]any{"sub": "user-1"}},
+		},
+		{
+			name: "claims mapped to subject",
+			ctx: contextWithAuthorization("Bearer " + ed.sign(t, valid(map[string]any{
+				"realm_access": map[string]any{"roles": []string{"admin", "editor"}},
+				"scope":        "orders:read orders:write",
+				"org":          map[string]any{"id": "acme"},
+				"acr":          "mfa",
+				"auth_time":    testNow.Add(-time.Minute).Unix(),
+			}))),
+			opts: []Option{
+				WithIssuer("https://auth.example.com"),
+				WithAudience("billing"),
+				WithRolesClaim("realm_access.roles"),
+				WithAttrClaims(map[string]string{"id": "sub", "tenant": "org.id", "missing": "org.name"}),
+				WithAuthLevels(map[string]int{"pwd": 1, "mfa": 2}),
+			},
+			want: &interceptor.Subject{
+				Roles:           []string{"admin", "editor"},
+				Scopes:          []string{"orders:read", "orders:write"},
+				Attrs:           map[string]any{"id": "user-1", "tenant": "acme"},
+				AuthLevel:       2,
+				AuthenticatedAt: time.Unix(testNow.Add(-time.Minute).Unix(), 0),
+			},
+		},
+		{
+			name: "numeric claims mapped to attributes",
+			ctx: contextWithAuthorization("Bearer " + hs.sign(t, valid(map[string]any{
+				"uid":   42,
+				"quota": 1.5,
+				"org":   map[string]any{"id": 7, "limits": []any{10, 2.5, "none"}},
+			}))),
+			opts: []Option{
+				WithAttrClaims(map[string]string{"uid": "uid", "quota": "quota", "org": "org"}),
+			},
+			want: &interceptor.Subject{
+				Attrs: map[string]any{
+					"uid":   int64(42),
+					"quota": 1.5,
+					"org":   map[string]any{"id": int64(7), "limits": []any{int64(10), 2.5, "none"}},
+				},
+			},
+		},
+		{
+			name:    "empty token",
+			ctx:     contextWithAuthorization("Bearer "),
+			wantErr: true,
+		},
+		{
+			name:    "malformed token",
+			ctx:     contextWithAuthorization("Bearer not-a-token"),
+			wantErr: true,
+		},
+		{
+			name:    "tampered claims",
+			ctx:     contextWithAuthorization("Bearer " + tamper(t, hs.sign(t, valid(nil)), valid(map[string]any{"roles": []string{"admin"}}))),
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			ctx:     contextWithAuthorization("Bearer " + testSigner{alg: AlgorithmHS256, kid: "hs", key: []byte("other secret")}.sign(t, valid(nil))),
+			wantErr: true,
+		},
+		{
+			name:    "key id of other key",
+			ctx:     contextWithAuthorization("Bearer " + testSigner{alg: AlgorithmRS256, kid: "es", key: rsaKey}.sign(t, valid(nil))),
+			wantErr: true,
+		},
+		{
+			name:    "algorithm not matching key",
+			ctx:     contextWithAuthorization("Bearer " + testSigner{alg: AlgorithmHS256, kid: "rs", key: secret}.sign(t, valid(nil))),
+			wantErr: true,
+		},
+		{
+			name:    "none algorithm",
+			ctx:     contextWithAuthorization("Bearer " + encodeSegment(t, map[string]any{"alg": "none"}) + "." + encodeSegment(t, valid(nil)) + "."),
+			wantErr: true,
+		},
+		{
+			name:    "expired token",
+			ctx:     contextWithAuthorization("Bearer " + hs.sign(t, valid(map[string]any{"exp": testNow.Add(-time.Minute).Unix()}))),
+			wantErr: true,
+		},
+		{
+			name: "expired token within clock skew",
+			ctx:  contextWithAuthorization("Bearer " + hs.sign(t, valid(map[string]any{"exp": testNow.Add(-10 * time.Second).Unix()}))),
+			want: &interceptor.Subject{Attrs: map[string]any{"sub": "user-1"}},
+		},
+		{
+			name:    "expired token without clock skew",
+			ctx:     contextWithAuthorization("Bearer " + hs.sign(t, valid(map[string]any{"exp": testNow.Add(-10 * time.Second).Unix()}))),
+			opts:    []Option{WithClockSkew(0)},
+			wantErr: true,
+		},
+		{
+			name:    "token without expiration",
+			ctx:     contextWithAuthorization("Bearer " + hs.sign(t, map[string]any{"sub": "user-1"})),
+			wantErr: true,
+		},
+		{
+			name:    "token not valid yet",
+			ctx:     contextWithAuthorization("Bearer " + hs.sign(t, valid(map[string]any{"nbf": testNow.Add(time.Minute).Unix()}))),
+			wantErr: true,
+		},
+		{
+			name:    "token with non-numeric expiration",
+			ctx:     contextWithAuthorization("Bearer " + hs.sign(t, valid(map[string]any{"exp": "tomorrow"}))),
+			wantErr: true,
+		},
+		{
+			name:    "unexpected issuer",
+			ctx:     contextWithAuthorization("Bearer " + hs.sign(t, valid(nil))),
+			opts:    []Option{WithIssuer("https://other.example.com")},
+			wantErr: true,
+		},
+		{
+			name:    "audience not accepted",
+			ctx:     contextWithAuthorization("Bearer " + hs.sign(t, valid(nil))),
+			opts:    []Option{WithAudience("inventory")},
+			wantErr: true,
+		},
+		{
+			name: "string audience accepted",
+			ctx:  contextWithAuthorization("Bearer " + hs.sign(t, valid(map[string]any{"aud": "orders"}))),
+			opts: []Option{WithAudience("orders")},
+			want: &interceptor.Subject{Attrs: map[string]any{"sub": "user-1"}},
+		},
+		{
+			name:    "string audience with spaces not split",
+			ctx:     contextWithAuthorization("Bearer " + hs.sign(t, valid(map[string]any{"aud": "orders-internal orders"}))),
+			opts:    []Option{WithAudience("orders")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolve := New(keys, append([]Option{WithClock(func() time.Time { return testNow })}, tt.opts...)...)
+
+			subject, err := resolve(tt.ctx)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, interceptor.ErrInvalidCredentials)
+				assert.ErrorIs(t, err, ErrInvalidToken)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, subject)
+		})
+	}
+}
+
+func Test_resolver_keySetError(t *testing.T) {
+	resolve := New(failingKeySet{}, WithClock(func() time.Time { return testNow }))
+
+	_, err := resolve(contextWithAuthorization("Bearer " + testSigner{alg: AlgorithmHS256, key: []byte("secret")}.sign(t, map[string]any{})))
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, interceptor.ErrInvalidCredentials)
+}
+
+type failingKeySet struct{}
+
+func (failingKeySet) Keys() ([]Key, error) {
+	return nil, errors.New("key store unavailable")
+}
+
+// tamper replaces the claims of the token, keeping its header and signature.
+func tamper(t *testing.T, token string, claims map[string]any) string {
+	t.Helper()
+
+	segments := strings.Split(token, ".")
+	require.Len(t, segments, 3)
+
+	return segments[0] + "." + encodeSegment(t, claims) + "." + segments[2]
+}
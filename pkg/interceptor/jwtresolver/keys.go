@@ -0,0 +1,237 @@
+package jwtresolver
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/casnerano/protoc-gen-go-guard/internal/filereload"
+)
+
+// Supported signing algorithms.
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// DefaultCheckInterval is the default minimum time between checks of a JWKS file for changes.
+const DefaultCheckInterval = filereload.DefaultCheckInterval
+
+var (
+	ErrUnsupportedKey = errors.New("unsupported key")
+	ErrInvalidJWKS    = errors.New("invalid JWKS")
+)
+
+// Key is a token verification key.
+// The type of Key defines the algorithm tokens must be signed with:
+//   - []byte — HS256;
+//   - *rsa.PublicKey — RS256;
+//   - *ecdsa.PublicKey on the P-256 curve — ES256;
+//   - ed25519.PublicKey — EdDSA.
+type Key struct {
+	ID  string // Key ID matched against the "kid" token header, if both are set.
+	Key any
+}
+
+// KeySet provides the keys tokens are verified with.
+type KeySet interface {
+	Keys() ([]Key, error)
+}
+
+// staticKeySet is a fixed set of keys.
+type staticKeySet []Key
+
+func (s staticKeySet) Keys() ([]Key, error) {
+	return s, nil
+}
+
+// StaticKeys returns a key set of the given keys.
+// It fails on keys of unsupported types.
+func StaticKeys(keys ...Key) (KeySet, error) {
+	for _, key := range keys {
+		if algorithm(key.Key) == "" {
+			return nil, fmt.Errorf("%w: key %q of type %T", ErrUnsupportedKey, key.ID, key.Key)
+		}
+	}
+
+	return staticKeySet(keys), nil
+}
+
+// fileKeySet is a JWKS file reloaded when it changes.
+type fileKeySet struct {
+	file *filereload.File[[]Key]
+}
+
+// JWKSFile returns a key set read from the JWKS file at the path.
+// The file is checked for changes at most once per check interval and reloaded if it changed,
+// so rotated keys are picked up without a restart. If the file cannot be read or parsed
+// after a change, requesting keys fails until the file is fixed, so tokens are rejected
+// rather than verified with keys the file no longer holds.
+// It fails if the file cannot be loaded initially.
+func JWKSFile(path string, opts ...FileOption) (KeySet, error) {
+	options := fileOptions{checkInterval: DefaultCheckInterval}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	file, err := filereload.New(path, options.checkInterval, ParseJWKS)
+	if err != nil {
+		return nil, fmt.Errorf("jwks file: %w", err)
+	}
+
+	return &fileKeySet{file: file}, nil
+}
+
+func (f *fileKeySet) Keys() ([]Key, error) {
+	keys, err := f.file.Load()
+	if err != nil {
+		return nil, fmt.Errorf("jwks file: %w", err)
+	}
+
+	return keys, nil
+}
+
+// jwk is a JSON Web Key of RFC 7517 with the parameters of supported key types.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+// ParseJWKS parses a JSON Web Key Set.
+// Encryption keys and keys of unsupported types or curves are skipped;
+// malformed parameters of supported keys are rejected.
+func ParseJWKS(data []byte) ([]Key, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJWKS, err)
+	}
+
+	keys := make([]Key, 0, len(set.Keys))
+	for idx, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.key()
+		if err != nil {
+			return nil, fmt.Errorf("%w: keys[%d]: %w", ErrInvalidJWKS, idx, err)
+		}
+
+		if key != nil {
+			keys = append(keys, Key{ID: jwk.Kid, Key: key})
+		}
+	}
+
+	return keys, nil
+}
+
+// key decodes the key parameters. Returns nil for unsupported key types and curves.
+func (k jwk) key() (any, error) {
+	switch {
+	case k.Kty == "oct":
+		return decodeParam("k", k.K)
+
+	case k.Kty == "RSA":
+		n, err := decodeParam("n", k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeParam("e", k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 2 || exponent.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid exponent")
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+
+	case k.Kty == "EC" && k.Crv == "P-256":
+		x, err := decodeParam("x", k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeParam("y", k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		// Parsing the uncompressed point encoding checks that the point is on the curve.
+		key, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return nil, fmt.Errorf("invalid point: %w", err)
+		}
+
+		return key, nil
+
+	case k.Kty == "OKP" && k.Crv == "Ed25519":
+		x, err := decodeParam("x", k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key size %d", len(x))
+		}
+
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func decodeParam(name, value string) ([]byte, error) {
+	if value == "" {
+		return nil, fmt.Errorf("missing %q", name)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", name, err)
+	}
+
+	return decoded, nil
+}
+
+// algorithm returns the signing algorithm of the key, or "" for unsupported keys.
+func algorithm(key any) string {
+	switch key := key.(type) {
+	case []byte:
+		if len(key) > 0 {
+			return AlgorithmHS256
+		}
+	case *rsa.PublicKey:
+		return AlgorithmRS256
+	case *ecdsa.PublicKey:
+		if key.Curve == elliptic.P256() {
+			return AlgorithmES256
+		}
+	case ed25519.PublicKey:
+		return AlgorithmEdDSA
+	}
+
+	return ""
+}
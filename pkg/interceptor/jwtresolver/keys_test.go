@@ -0,0 +1,157 @@
+package jwtresolver
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseJWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	edPublic, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	encode := base64.RawURLEncoding.EncodeToString
+	ecPoint, err := ecKey.PublicKey.Bytes()
+	require.NoError(t, err)
+
+	jwks := fmt.Sprintf(`{"keys": [
+		{"kty": "RSA", "kid": "rs", "use": "sig", "n": %q, "e": %q},
+		{"kty": "EC", "kid": "es", "crv": "P-256", "x": %q, "y": %q},
+		{"kty": "OKP", "kid": "ed", "crv": "Ed25519", "x": %q},
+		{"kty": "oct", "kid": "hs", "k": %q},
+		{"kty": "RSA", "kid": "enc", "use": "enc", "n": %q, "e": %q},
+		{"kty": "EC", "kid": "p384", "crv": "P-384", "x": "AA", "y": "AA"}
+	]}`,
+		encode(rsaKey.N.Bytes()), encode(big.NewInt(int64(rsaKey.E)).Bytes()),
+		encode(ecPoint[1:33]), encode(ecPoint[33:]),
+		encode(edPublic),
+		encode([]byte("secret")),
+		encode(rsaKey.N.Bytes()), encode(big.NewInt(int64(rsaKey.E)).Bytes()),
+	)
+
+	keys, err := ParseJWKS([]byte(jwks))
+	require.NoError(t, err)
+	require.Len(t, keys, 4)
+
+	assert.Equal(t, Key{ID: "rs", Key: &rsaKey.PublicKey}, keys[0])
+	assert.Equal(t, "es", keys[1].ID)
+	assert.True(t, ecKey.PublicKey.Equal(keys[1].Key))
+	assert.Equal(t, Key{ID: "ed", Key: edPublic}, keys[2])
+	assert.Equal(t, Key{ID: "hs", Key: []byte("secret")}, keys[3])
+}
+
+func Test_ParseJWKS_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		jwks string
+	}{
+		{
+			name: "not json",
+			jwks: `keys`,
+		},
+		{
+			name: "rsa key without modulus",
+			jwks: `{"keys": [{"kty": "RSA", "e": "AQAB"}]}`,
+		},
+		{
+			name: "ec point not on curve",
+			jwks: `{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`,
+		},
+		{
+			name: "ed25519 key of wrong size",
+			jwks: `{"keys": [{"kty": "OKP", "crv": "Ed25519", "x": "AQID"}]}`,
+		},
+		{
+			name: "symmetric key not base64url",
+			jwks: `{"keys": [{"kty": "oct", "k": "!!"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseJWKS([]byte(tt.jwks))
+			assert.ErrorIs(t, err, ErrInvalidJWKS)
+		})
+	}
+}
+
+func Test_StaticKeys(t *testing.T) {
+	_, err := StaticKeys(Key{ID: "hs", Key: []byte("secret")})
+	require.NoError(t, err)
+
+	_, err = StaticKeys(Key{ID: "empty", Key: []byte{}})
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	_, err = StaticKeys(Key{ID: "p384", Key: &p384.PublicKey})
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+
+	_, err = StaticKeys(Key{ID: "private", Key: p384})
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+}
+
+func Test_JWKSFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+
+	write := func(jwks string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(jwks), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	modTime := time.Now().Add(-time.Hour)
+
+	_, err := JWKSFile(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	write(`{"keys": [{"kty": "oct", "kid": "v1", "k": "c2VjcmV0"}]}`, modTime)
+
+	set, err := JWKSFile(path, WithCheckInterval(0))
+	require.NoError(t, err)
+
+	keys, err := set.Keys()
+	require.NoError(t, err)
+	assert.Equal(t, []Key{{ID: "v1", Key: []byte("secret")}}, keys)
+
+	// Rotated keys are picked up.
+	modTime = modTime.Add(time.Minute)
+	write(`{"keys": [{"kty": "oct", "kid": "v2", "k": "c2VjcmV0"}]}`, modTime)
+
+	keys, err = set.Keys()
+	require.NoError(t, err)
+	assert.Equal(t, []Key{{ID: "v2", Key: []byte("secret")}}, keys)
+
+	// A broken update fails rather than keeping the previous keys.
+	modTime = modTime.Add(time.Minute)
+	write(`{"keys": [`, modTime)
+
+	_, err = set.Keys()
+	assert.ErrorIs(t, err, ErrInvalidJWKS)
+
+	// A fixed update is picked up.
+	modTime = modTime.Add(time.Minute)
+	write(`{"keys": [{"kty": "oct", "kid": "v3", "k": "c2VjcmV0"}]}`, modTime)
+
+	keys, err = set.Keys()
+	require.NoError(t, err)
+	assert.Equal(t, []Key{{ID: "v3", Key: []byte("secret")}}, keys)
+}
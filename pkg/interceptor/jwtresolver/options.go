@@ -0,0 +1,92 @@
+package jwtresolver
+
+import (
+	"time"
+)
+
+// Option configures the resolver.
+type Option func(r *resolver)
+
+// WithIssuer requires the "iss" claim to equal the issuer.
+func WithIssuer(issuer string) Option {
+	return func(r *resolver) {
+		r.issuer = issuer
+	}
+}
+
+// WithAudience requires the "aud" claim to equal the audience or, if it is an array, to contain it.
+func WithAudience(audience string) Option {
+	return func(r *resolver) {
+		r.audience = audience
+	}
+}
+
+// WithClockSkew sets the tolerance of "exp" and "nbf" checks for clock differences
+// between the issuer and the server. Defaults to DefaultClockSkew.
+func WithClockSkew(skew time.Duration) Option {
+	return func(r *resolver) {
+		if skew >= 0 {
+			r.clockSkew = skew
+		}
+	}
+}
+
+// WithClock sets the source of the current time used to check token lifetime.
+// Defaults to time.Now.
+func WithClock(clock func() time.Time) Option {
+	return func(r *resolver) {
+		if clock != nil {
+			r.clock = clock
+		}
+	}
+}
+
+// WithRolesClaim sets the claim holding subject roles, e.g. "realm_access.roles".
+// Defaults to DefaultRolesClaim.
+func WithRolesClaim(claim string) Option {
+	return func(r *resolver) {
+		r.rolesClaim = claim
+	}
+}
+
+// WithScopesClaim sets the claim holding OAuth2 scopes granted to the client, e.g. "scp".
+// Defaults to DefaultScopesClaim.
+func WithScopesClaim(claim string) Option {
+	return func(r *resolver) {
+		r.scopesClaim = claim
+	}
+}
+
+// WithAttrClaims sets the claims copied to subject attributes, keyed by attribute name,
+// e.g. {"id": "sub", "tenant": "org.id"}. Defaults to the "sub" claim as the "sub" attribute.
+// Integer claims become int64 attributes and other numbers float64, including nested ones.
+func WithAttrClaims(claims map[string]string) Option {
+	return func(r *resolver) {
+		r.attrClaims = claims
+	}
+}
+
+// WithAuthLevels maps values of the "acr" claim to subject authentication levels
+// checked by step-up rules, e.g. {"pwd": 1, "mfa": 2}. Unknown values map to level 0.
+func WithAuthLevels(levels map[string]int) Option {
+	return func(r *resolver) {
+		r.authLevels = levels
+	}
+}
+
+// FileOption configures a key set read from a file.
+type FileOption func(o *fileOptions)
+
+type fileOptions struct {
+	checkInterval time.Duration
+}
+
+// WithCheckInterval sets the minimum time between checks of the file for changes,
+// zero checking it whenever keys are requested. Defaults to DefaultCheckInterval.
+func WithCheckInterval(interval time.Duration) FileOption {
+	return func(o *fileOptions) {
+		if interval >= 0 {
+			o.checkInterval = interval
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package interceptor
+
+import (
+	"context"
+	"slices"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataMismatch returns the key of the first entry the incoming metadata does not match.
+// Returns false if it matches all of them.
+func metadataMismatch(ctx context.Context, matches []*guard.MetadataMatch) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, match := range matches {
+		values := md.Get(match.Key)
+
+		var matched bool
+		switch {
+		case match.Absent:
+			matched = len(values) == 0
+		case len(match.Values) == 0:
+			matched = len(values) > 0
+		default:
+			matched = slices.ContainsFunc(values, func(value string) bool {
+				return slices.Contains(match.Values, value)
+			})
+		}
+
+		if !matched {
+			return match.Key, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func Test_metadataMismatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		matches    []*guard.MetadataMatch
+		wantKey    string
+		mismatched bool
+	}{
+		{
+			name:       "no matches",
+			md:         metadata.Pairs("x-client-app", "ios"),
+			mismatched: false,
+		},
+		{
+			name:       "present key",
+			md:         metadata.Pairs("x-request-reason", "incident"),
+			matches:    []*guard.MetadataMatch{{Key: "x-request-reason"}},
+			mismatched: false,
+		},
+		{
+			name:       "missing key",
+			md:         metadata.Pairs("x-client-app", "ios"),
+			matches:    []*guard.MetadataMatch{{Key: "x-request-reason"}},
+			wantKey:    "x-request-reason",
+			mismatched: true,
+		},
+		{
+			name:       "allowed value",
+			md:         metadata.Pairs("x-client-app", "web", "x-client-app", "android"),
+			matches:    []*guard.MetadataMatch{{Key: "x-client-app", Values: []string{"ios", "android"}}},
+			mismatched: false,
+		},
+		{
+			name:       "value not allowed",
+			md:         metadata.Pairs("x-client-app", "web"),
+			matches:    []*guard.MetadataMatch{{Key: "x-client-app", Values: []string{"ios", "android"}}},
+			wantKey:    "x-client-app",
+			mismatched: true,
+		},
+		{
+			name:       "absent key",
+			md:         metadata.Pairs("x-client-app", "ios"),
+			matches:    []*guard.MetadataMatch{{Key: "x-debug", Absent: true}},
+			mismatched: false,
+		},
+		{
+			name:       "forbidden key present",
+			md:         metadata.Pairs("x-debug", "1"),
+			matches:    []*guard.MetadataMatch{{Key: "x-debug", Absent: true}},
+			wantKey:    "x-debug",
+			mismatched: true,
+		},
+		{
+			name: "first mismatched key",
+			md:   metadata.Pairs("x-client-app", "ios", "x-debug", "1"),
+			matches: []*guard.MetadataMatch{
+				{Key: "x-client-app", Values: []string{"ios"}},
+				{Key: "x-debug", Absent: true},
+				{Key: "x-request-reason"},
+			},
+			wantKey:    "x-debug",
+			mismatched: true,
+		},
+		{
+			name:       "no incoming metadata",
+			matches:    []*guard.MetadataMatch{{Key: "x-request-reason"}},
+			wantKey:    "x-request-reason",
+			mismatched: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			key, mismatched := metadataMismatch(ctx, tt.matches)
+			assert.Equal(t, tt.mismatched, mismatched)
+			assert.Equal(t, tt.wantKey, key)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// forwardedForHeader is the metadata key of client addresses appended by proxies.
+const forwardedForHeader = "x-forwarded-for"
+
+// networkAllowed checks if the client address belongs to the network.
+// Unknown addresses are never allowed.
+func networkAllowed(network *guard.Network, addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+
+	for _, prefix := range network.Deny {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	if len(network.Allow) == 0 {
+		return true
+	}
+
+	for _, prefix := range network.Allow {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peerAddress returns the client address of the request, or the zero address if it is unknown.
+// Requests coming from trusted proxies are attributed to the rightmost address
+// of the x-forwarded-for metadata that is not a trusted proxy itself.
+func (i *Interceptor) peerAddress(ctx context.Context) netip.Addr {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}
+	}
+
+	addr := parseNetAddr(p.Addr)
+	if !i.trustedProxy(addr) {
+		return addr
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var hops []string
+	for _, value := range md.Get(forwardedForHeader) {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+
+	for idx := len(hops) - 1; idx >= 0; idx-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[idx]))
+		if err != nil {
+			return netip.Addr{}
+		}
+
+		addr = hop.Unmap()
+		if !i.trustedProxy(addr) {
+			break
+		}
+	}
+
+	return addr
+}
+
+// trustedProxy checks if the address belongs to one of the trusted proxy ranges.
+func (i *Interceptor) trustedProxy(addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+
+	for _, prefix := range i.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseNetAddr returns the IP address of a network address, or the zero address
+// for addresses without one, e.g. of in-memory connections.
+func parseNetAddr(addr net.Addr) netip.Addr {
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		ip, _ := netip.AddrFromSlice(tcpAddr.IP)
+		return ip.Unmap()
+	}
+
+	if addrPort, err := netip.ParseAddrPort(addr.String()); err == nil {
+		return addrPort.Addr().Unmap()
+	}
+
+	ip, _ := netip.ParseAddr(addr.String())
+
+	return ip.Unmap()
+}
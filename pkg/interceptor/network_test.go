@@ -0,0 +1,172 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func Test_networkAllowed(t *testing.T) {
+	network := &guard.Network{
+		Allow: []netip.Prefix{netip.MustParsePrefix("10.8.0.0/16"), netip.MustParsePrefix("2001:db8::/32")},
+		Deny:  []netip.Prefix{netip.MustParsePrefix("10.8.255.0/24")},
+	}
+
+	tests := []struct {
+		name    string
+		network *guard.Network
+		addr    netip.Addr
+		want    bool
+	}{
+		{
+			name:    "address in allowed range",
+			network: network,
+			addr:    netip.MustParseAddr("10.8.1.2"),
+			want:    true,
+		},
+		{
+			name:    "ipv6 address in allowed range",
+			network: network,
+			addr:    netip.MustParseAddr("2001:db8::1"),
+			want:    true,
+		},
+		{
+			name:    "address outside of allowed ranges",
+			network: network,
+			addr:    netip.MustParseAddr("203.0.113.5"),
+			want:    false,
+		},
+		{
+			name:    "address in denied part of allowed range",
+			network: network,
+			addr:    netip.MustParseAddr("10.8.255.1"),
+			want:    false,
+		},
+		{
+			name:    "unknown address",
+			network: network,
+			addr:    netip.Addr{},
+			want:    false,
+		},
+		{
+			name:    "any address not denied",
+			network: &guard.Network{Deny: []netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")}},
+			addr:    netip.MustParseAddr("10.8.1.2"),
+			want:    true,
+		},
+		{
+			name:    "denied address without allowed ranges",
+			network: &guard.Network{Deny: []netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")}},
+			addr:    netip.MustParseAddr("203.0.113.5"),
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, networkAllowed(tt.network, tt.addr))
+		})
+	}
+}
+
+func Test_interceptor_peerAddress(t *testing.T) {
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")}
+
+	tests := []struct {
+		name           string
+		peer           net.Addr
+		forwardedFor   []string
+		trustedProxies []netip.Prefix
+		want           netip.Addr
+	}{
+		{
+			name: "without peer",
+			want: netip.Addr{},
+		},
+		{
+			name: "tcp peer",
+			peer: &net.TCPAddr{IP: net.ParseIP("10.8.1.2"), Port: 50051},
+			want: netip.MustParseAddr("10.8.1.2"),
+		},
+		{
+			name: "ipv6 peer",
+			peer: &net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 50051},
+			want: netip.MustParseAddr("2001:db8::1"),
+		},
+		{
+			name: "peer without ip address",
+			peer: &net.UnixAddr{Name: "/run/server.sock", Net: "unix"},
+			want: netip.Addr{},
+		},
+		{
+			name:         "forwarded for is ignored without trusted proxies",
+			peer:         &net.TCPAddr{IP: net.ParseIP("192.0.2.1")},
+			forwardedFor: []string{"10.8.1.2"},
+			want:         netip.MustParseAddr("192.0.2.1"),
+		},
+		{
+			name:           "forwarded for from untrusted peer",
+			peer:           &net.TCPAddr{IP: net.ParseIP("203.0.113.5")},
+			forwardedFor:   []string{"10.8.1.2"},
+			trustedProxies: trustedProxies,
+			want:           netip.MustParseAddr("203.0.113.5"),
+		},
+		{
+			name:           "forwarded for from trusted proxy",
+			peer:           &net.TCPAddr{IP: net.ParseIP("192.0.2.1")},
+			forwardedFor:   []string{"10.8.1.2"},
+			trustedProxies: trustedProxies,
+			want:           netip.MustParseAddr("10.8.1.2"),
+		},
+		{
+			name:           "rightmost untrusted address in forwarded for",
+			peer:           &net.TCPAddr{IP: net.ParseIP("192.0.2.1")},
+			forwardedFor:   []string{"10.8.1.2, 203.0.113.5", "192.0.2.2"},
+			trustedProxies: trustedProxies,
+			want:           netip.MustParseAddr("203.0.113.5"),
+		},
+		{
+			name:           "forwarded for with trusted proxies only",
+			peer:           &net.TCPAddr{IP: net.ParseIP("192.0.2.1")},
+			forwardedFor:   []string{"192.0.2.3,192.0.2.2"},
+			trustedProxies: trustedProxies,
+			want:           netip.MustParseAddr("192.0.2.3"),
+		},
+		{
+			name:           "malformed forwarded for",
+			peer:           &net.TCPAddr{IP: net.ParseIP("192.0.2.1")},
+			forwardedFor:   []string{"unknown"},
+			trustedProxies: trustedProxies,
+			want:           netip.Addr{},
+		},
+		{
+			name:           "trusted proxy without forwarded for",
+			peer:           &net.TCPAddr{IP: net.ParseIP("192.0.2.1")},
+			trustedProxies: trustedProxies,
+			want:           netip.MustParseAddr("192.0.2.1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New(nil, WithTrustedProxies(tt.trustedProxies...))
+
+			ctx := context.Background()
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tt.peer})
+			}
+
+			if len(tt.forwardedFor) > 0 {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{forwardedForHeader: tt.forwardedFor})
+			}
+
+			assert.Equal(t, tt.want, i.peerAddress(ctx))
+		})
+	}
+}
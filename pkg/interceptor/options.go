@@ -1,6 +1,11 @@
 package interceptor
 
-import "github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+import (
+	"net/netip"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
 
 // Option configures the behavior of the interceptor.
 type Option func(i *Interceptor)
@@ -20,6 +25,25 @@ func WithPolicies(policies Policies) Option {
 	}
 }
 
+// WithAuthenticators registers named subject resolvers, e.g. "jwt" and "mtls",
+// referenced by authentication options of services and methods in .proto files.
+// Requests to such methods are authenticated by the accepted authenticators only,
+// instead of the resolver passed to New.
+func WithAuthenticators(authenticators Authenticators) Option {
+	return func(i *Interceptor) {
+		i.authenticators = authenticators
+	}
+}
+
+// WithRoleHierarchy declares roles implied by other roles, e.g. admin → editor → viewer.
+// Subject roles are expanded transitively before matching role-based rules,
+// together with the role hierarchy declared in .proto files.
+func WithRoleHierarchy(hierarchy guard.RoleHierarchy) Option {
+	return func(i *Interceptor) {
+		i.roleHierarchy = hierarchy
+	}
+}
+
 // WithDefaultRules sets global fallback rules applied when a service
 // has no service-level or method-level rules defined.
 // By default, the interceptor uses a zero-trust model (deny all).
@@ -29,6 +53,36 @@ func WithDefaultRules(rules guard.Rules) Option {
 	}
 }
 
+// WithClock sets the source of the current time used to evaluate time constraints of rules.
+// Defaults to time.Now.
+func WithClock(clock func() time.Time) Option {
+	return func(i *Interceptor) {
+		if clock != nil {
+			i.clock = clock
+		}
+	}
+}
+
+// WithTrustedProxies declares address ranges of proxies in front of the server.
+// Requests coming from them are attributed to the client address in the
+// x-forwarded-for metadata, used by network rules and exposed as Input.Peer.
+// By default, x-forwarded-for is ignored.
+func WithTrustedProxies(proxies ...netip.Prefix) Option {
+	return func(i *Interceptor) {
+		i.trustedProxies = append(i.trustedProxies, proxies...)
+	}
+}
+
+// WithErrorDomain sets the domain of ErrorInfo details attached to denials,
+// typically the DNS name of the service. Defaults to DefaultErrorDomain.
+func WithErrorDomain(domain string) Option {
+	return func(i *Interceptor) {
+		if domain != "" {
+			i.errorDomain = domain
+		}
+	}
+}
+
 // WithOnError registers a handler invoked when an internal error occurs
 // during subject resolution or rule evaluation.
 func WithOnError(handler OnErrorHandler) Option {
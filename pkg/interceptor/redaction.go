@@ -0,0 +1,153 @@
+package interceptor
+
+import (
+	"context"
+	"log"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redactResponse returns the response with fields whose field-level rules do not allow access
+// for the current subject cleared. Handlers may return shared messages, e.g. cached ones,
+// so fields are cleared on a copy, made only if any field is hidden.
+// Returns a gRPC error if rule evaluation fails.
+func (i *Interceptor) redactResponse(ctx context.Context, server any, fullMethod string, resp any, input *Input) (any, error) {
+	service := i.getGuardService(server)
+	if service == nil || len(service.Messages) == 0 {
+		return resp, nil
+	}
+
+	message, ok := resp.(proto.Message)
+	if !ok || message == nil {
+		return resp, nil
+	}
+
+	r := redactor{
+		interceptor: i,
+		messages:    service.Messages,
+		input:       input,
+		decisions:   make(map[*guard.Field]bool),
+	}
+
+	err := r.redact(ctx, message.ProtoReflect())
+	if err == nil && r.hidden {
+		message = proto.Clone(message)
+		r.clear = true
+		err = r.redact(ctx, message.ProtoReflect())
+	}
+
+	if err != nil {
+		if i.debug {
+			log.Printf("Redaction error for %s: %v", fullMethod, err)
+		}
+
+		if i.eventHandlers.OnError != nil {
+			i.eventHandlers.OnError(ctx, input, err)
+		}
+
+		return nil, status.Error(codes.Internal, "evaluation error")
+	}
+
+	return message, nil
+}
+
+// redactor walks a message tree looking for fields hidden from the subject
+// and, once clear is set, clears them.
+// Field decisions are cached, so rules are evaluated once per field regardless
+// of how many times it occurs in repeated or map values or how many times the tree is walked.
+type redactor struct {
+	interceptor *Interceptor
+	messages    map[string]*guard.Message
+	input       *Input
+	decisions   map[*guard.Field]bool
+	clear       bool
+	hidden      bool
+}
+
+func (r *redactor) redact(ctx context.Context, message protoreflect.Message) error {
+	guardMessage, exists := r.messages[string(message.Descriptor().FullName())]
+	if !exists {
+		return nil
+	}
+
+	var err error
+	message.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field, guarded := guardMessage.Fields[string(fd.Name())]; guarded && field.Rules != nil {
+			var allowed bool
+			if allowed, err = r.allowed(ctx, field); err != nil {
+				return false
+			}
+
+			if !allowed {
+				r.hidden = true
+				if r.clear {
+					message.Clear(fd)
+				}
+
+				return r.proceed()
+			}
+		}
+
+		err = r.redactValue(ctx, fd, value)
+		return err == nil && r.proceed()
+	})
+
+	return err
+}
+
+// proceed reports whether the walk goes on. Looking for hidden fields stops at the first one.
+func (r *redactor) proceed() bool {
+	return r.clear || !r.hidden
+}
+
+// redactValue descends into singular, repeated and map message values.
+func (r *redactor) redactValue(ctx context.Context, fd protoreflect.FieldDescriptor, value protoreflect.Value) error {
+	var err error
+
+	switch {
+	case fd.IsMap():
+		if fd.MapValue().Message() == nil {
+			return nil
+		}
+
+		value.Map().Range(func(_ protoreflect.MapKey, mapValue protoreflect.Value) bool {
+			err = r.redact(ctx, mapValue.Message())
+			return err == nil && r.proceed()
+		})
+
+	case fd.IsList():
+		if fd.Message() == nil {
+			return nil
+		}
+
+		list := value.List()
+		for j := 0; j < list.Len() && err == nil && r.proceed(); j++ {
+			err = r.redact(ctx, list.Get(j).Message())
+		}
+
+	case fd.Message() != nil:
+		err = r.redact(ctx, value.Message())
+	}
+
+	return err
+}
+
+// allowed evaluates field rules for the current subject.
+func (r *redactor) allowed(ctx context.Context, field *guard.Field) (bool, error) {
+	if allowed, cached := r.decisions[field]; cached {
+		return allowed, nil
+	}
+
+	result, err := r.interceptor.evaluateRules(ctx, field.Rules, r.input)
+	if err != nil {
+		return false, err
+	}
+
+	r.decisions[field] = result.Allowed
+
+	return result.Allowed, nil
+}
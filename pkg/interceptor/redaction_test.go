@@ -0,0 +1,231 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_interceptor_redactResponse(t *testing.T) {
+	data := struct {
+		adminRule *guard.Rule
+	}{
+		adminRule: &guard.Rule{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{
+					Roles:       []string{"admin"},
+					Requirement: guard.RequirementAtLeastOne,
+				},
+			},
+		},
+	}
+
+	messages := map[string]*guard.Message{
+		"google.protobuf.Api": {
+			Fields: map[string]*guard.Field{
+				"version": {Rules: guard.Rules{data.adminRule}},
+			},
+		},
+		"google.protobuf.Method": {
+			Fields: map[string]*guard.Field{
+				"request_type_url": {Rules: guard.Rules{data.adminRule}},
+			},
+		},
+		"google.protobuf.SourceContext": {
+			Fields: map[string]*guard.Field{
+				"file_name": {Rules: guard.Rules{{RequireAuthentication: guard.Ptr(true)}}},
+			},
+		},
+		"google.protobuf.Struct": {},
+		"google.protobuf.Value": {
+			Fields: map[string]*guard.Field{
+				"string_value": {Rules: guard.Rules{data.adminRule}},
+			},
+		},
+	}
+
+	newAPI := func() *apipb.Api {
+		return &apipb.Api{
+			Name:    "api",
+			Version: "v1",
+			Methods: []*apipb.Method{
+				{Name: "first", RequestTypeUrl: "first-url"},
+				{Name: "second", RequestTypeUrl: "second-url"},
+			},
+			SourceContext: &sourcecontextpb.SourceContext{FileName: "api.proto"},
+		}
+	}
+
+	newStruct := func() *structpb.Struct {
+		return &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"email": structpb.NewStringValue("user@example.com"),
+				"age":   structpb.NewNumberValue(42),
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		messages map[string]*guard.Message
+		input    Input
+		resp     proto.Message
+		want     proto.Message
+	}{
+		{
+			name:     "service without messages",
+			messages: nil,
+			input:    Input{},
+			resp:     newAPI(),
+			want:     newAPI(),
+		},
+		{
+			name:     "all guarded fields cleared for unauthenticated",
+			messages: messages,
+			input:    Input{},
+			resp:     newAPI(),
+			want: &apipb.Api{
+				Name: "api",
+				Methods: []*apipb.Method{
+					{Name: "first"},
+					{Name: "second"},
+				},
+				SourceContext: &sourcecontextpb.SourceContext{},
+			},
+		},
+		{
+			name:     "role guarded fields cleared for authenticated",
+			messages: messages,
+			input:    Input{Subject: &Subject{Roles: []string{"user"}}},
+			resp:     newAPI(),
+			want: &apipb.Api{
+				Name: "api",
+				Methods: []*apipb.Method{
+					{Name: "first"},
+					{Name: "second"},
+				},
+				SourceContext: &sourcecontextpb.SourceContext{FileName: "api.proto"},
+			},
+		},
+		{
+			name:     "all fields visible for admin",
+			messages: messages,
+			input:    Input{Subject: &Subject{Roles: []string{"admin"}}},
+			resp:     newAPI(),
+			want:     newAPI(),
+		},
+		{
+			name:     "map values redacted",
+			messages: messages,
+			input:    Input{Subject: &Subject{Roles: []string{"user"}}},
+			resp:     newStruct(),
+			want: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"email": {},
+					"age":   structpb.NewNumberValue(42),
+				},
+			},
+		},
+		{
+			name:     "message not listed is left untouched",
+			messages: map[string]*guard.Message{"google.protobuf.Method": messages["google.protobuf.Method"]},
+			input:    Input{},
+			resp:     newAPI(),
+			want:     newAPI(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &Interceptor{}
+			server := mockGuardServiceProvider{service: &guard.Service{Messages: tt.messages}}
+
+			original := proto.Clone(tt.resp)
+
+			got, err := i.redactResponse(context.Background(), server, "/pkg.Service/Method", tt.resp, &tt.input)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.want, got.(proto.Message)), "got %v", got)
+			assert.True(t, proto.Equal(original, tt.resp), "response modified: %v", tt.resp)
+		})
+	}
+}
+
+func Test_interceptor_redactResponse_evaluationError(t *testing.T) {
+	i := &Interceptor{}
+	server := mockGuardServiceProvider{
+		service: &guard.Service{
+			Messages: map[string]*guard.Message{
+				"google.protobuf.Api": {
+					Fields: map[string]*guard.Field{
+						"version": {
+							Rules: guard.Rules{
+								{
+									AuthenticatedAccess: &guard.AuthenticatedAccess{
+										PolicyBased: &guard.PolicyBased{
+											Policies:    []string{"undefined-policy"},
+											Requirement: guard.RequirementAll,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := i.redactResponse(context.Background(), server, "/pkg.Service/Method", &apipb.Api{Version: "v1"}, &Input{Subject: &Subject{}})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func Test_interceptor_Unary_sharedResponse(t *testing.T) {
+	server := mockGuardServiceProvider{service: &guard.Service{
+		Name:  "Service",
+		Rules: guard.Rules{{AllowPublic: guard.Ptr(true)}},
+		Messages: map[string]*guard.Message{
+			"google.protobuf.SourceContext": {
+				Fields: map[string]*guard.Field{
+					"file_name": {Rules: guard.Rules{{RequireAuthentication: guard.Ptr(true)}}},
+				},
+			},
+		},
+	}}
+
+	i := New(func(ctx context.Context) (*Subject, error) {
+		if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("x-user")) > 0 {
+			return &Subject{}, nil
+		}
+
+		return nil, nil
+	})
+
+	// The handler returns the same message to every caller, e.g. from a cache.
+	shared := &sourcecontextpb.SourceContext{FileName: "api.proto"}
+	info := &grpc.UnaryServerInfo{Server: server, FullMethod: "/pkg.Service/Method"}
+	handler := func(context.Context, any) (any, error) {
+		return shared, nil
+	}
+
+	resp, err := i.Unary()(context.Background(), &emptypb.Empty{}, info, handler)
+	require.NoError(t, err)
+	assert.Empty(t, resp.(*sourcecontextpb.SourceContext).GetFileName())
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user", "user"))
+	resp, err = i.Unary()(ctx, &emptypb.Empty{}, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "api.proto", resp.(*sourcecontextpb.SourceContext).GetFileName())
+	assert.Equal(t, "api.proto", shared.GetFileName())
+}
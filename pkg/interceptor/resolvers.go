@@ -0,0 +1,107 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ChainMode defines how ChainResolvers combines authentication schemes.
+type ChainMode int
+
+const (
+	// ChainFirst resolves schemes in order until one of them presents credentials:
+	// its subject or error is returned, and later schemes are not resolved.
+	ChainFirst ChainMode = iota
+	// ChainExclusive resolves every scheme and rejects requests presenting credentials
+	// of more than one of them with ErrAmbiguousCredentials.
+	ChainExclusive
+)
+
+// ErrUndefinedAuthenticator is returned for methods accepting an authenticator not registered with the interceptor.
+var ErrUndefinedAuthenticator = errors.New("undefined authenticator")
+
+// ErrAmbiguousCredentials is returned by exclusive resolver chains for requests presenting
+// credentials of multiple schemes. It wraps ErrInvalidCredentials.
+var ErrAmbiguousCredentials = fmt.Errorf("%w: credentials of multiple authentication schemes", ErrInvalidCredentials)
+
+// Scheme is a subject resolver of a named authentication scheme, e.g. "mtls", "jwt" or "api-key".
+type Scheme struct {
+	Name     string
+	Resolver SubjectResolver
+}
+
+// ChainResolvers returns a SubjectResolver trying the schemes in order, combined according to the mode.
+// A scheme presents credentials if it resolves a subject or fails with an error wrapping ErrInvalidCredentials;
+// other errors are returned immediately. The resolved subject records the name of its scheme in Subject.Scheme.
+func ChainResolvers(mode ChainMode, schemes ...Scheme) SubjectResolver {
+	return func(ctx context.Context) (*Subject, error) {
+		var (
+			subject   *Subject
+			presented *Scheme
+			err       error
+		)
+
+		for idx := range schemes {
+			scheme := &schemes[idx]
+
+			schemeSubject, schemeErr := scheme.Resolver(ctx)
+			if schemeErr != nil && !errors.Is(schemeErr, ErrInvalidCredentials) {
+				return nil, fmt.Errorf("scheme %q: %w", scheme.Name, schemeErr)
+			}
+
+			if schemeSubject == nil && schemeErr == nil {
+				continue
+			}
+
+			if presented != nil {
+				return nil, fmt.Errorf("%w: %q and %q", ErrAmbiguousCredentials, presented.Name, scheme.Name)
+			}
+
+			presented, subject, err = scheme, schemeSubject, schemeErr
+
+			if mode == ChainFirst {
+				break
+			}
+		}
+
+		switch {
+		case presented == nil:
+			return nil, nil
+		case err != nil:
+			return nil, fmt.Errorf("scheme %q: %w", presented.Name, err)
+		}
+
+		resolved := *subject
+		resolved.Scheme = presented.Name
+
+		return &resolved, nil
+	}
+}
+
+// resolveSubject resolves the subject of the request to the method. Methods accepting particular
+// authenticators are authenticated by the first of them the request presents credentials of,
+// recorded in Subject.Scheme; credentials of other schemes are not looked at.
+// Other methods use the subject resolver of the interceptor, if any.
+func (i *Interceptor) resolveSubject(ctx context.Context, server any, fullMethod string) (*Subject, error) {
+	names := i.getAuthenticators(server, fullMethod)
+	if len(names) == 0 {
+		if i.subjectResolver == nil {
+			return nil, nil
+		}
+
+		return i.subjectResolver(ctx)
+	}
+
+	schemes := make([]Scheme, 0, len(names))
+	for _, name := range names {
+		resolver, exists := i.authenticators[name]
+		if !exists {
+			return nil, fmt.Errorf("authenticator %q not defined: %w", name, ErrUndefinedAuthenticator)
+		}
+
+		schemes = append(schemes, Scheme{Name: name, Resolver: resolver})
+	}
+
+	return ChainResolvers(ChainFirst, schemes...)(ctx)
+}
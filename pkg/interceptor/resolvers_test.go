@@ -0,0 +1,229 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChainResolvers(t *testing.T) {
+	var (
+		errExpired  = fmt.Errorf("%w: token expired", ErrInvalidCredentials)
+		errInternal = errors.New("key store unavailable")
+	)
+
+	resolved := func(subject *Subject) SubjectResolver {
+		return func(context.Context) (*Subject, error) { return subject, nil }
+	}
+
+	failed := func(err error) SubjectResolver {
+		return func(context.Context) (*Subject, error) { return nil, err }
+	}
+
+	unreachable := func(t *testing.T) SubjectResolver {
+		return func(context.Context) (*Subject, error) {
+			t.Error("unexpected scheme resolution")
+			return nil, nil
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mode    ChainMode
+		schemes func(t *testing.T) []Scheme
+		want    *Subject
+		wantErr error
+	}{
+		{
+			name: "no credentials",
+			mode: ChainFirst,
+			schemes: func(*testing.T) []Scheme {
+				return []Scheme{{"mtls", resolved(nil)}, {"jwt", resolved(nil)}}
+			},
+			want: nil,
+		},
+		{
+			name: "first scheme with credentials wins",
+			mode: ChainFirst,
+			schemes: func(t *testing.T) []Scheme {
+				return []Scheme{
+					{"mtls", resolved(nil)},
+					{"jwt", resolved(&Subject{Roles: []string{"user"}})},
+					{"api-key", unreachable(t)},
+				}
+			},
+			want: &Subject{Roles: []string{"user"}, Scheme: "jwt"},
+		},
+		{
+			name: "invalid credentials stop the chain",
+			mode: ChainFirst,
+			schemes: func(t *testing.T) []Scheme {
+				return []Scheme{{"jwt", failed(errExpired)}, {"api-key", unreachable(t)}}
+			},
+			wantErr: errExpired,
+		},
+		{
+			name: "resolver failure",
+			mode: ChainFirst,
+			schemes: func(t *testing.T) []Scheme {
+				return []Scheme{{"jwt", resolved(nil)}, {"api-key", failed(errInternal)}}
+			},
+			wantErr: errInternal,
+		},
+		{
+			name: "exclusive single scheme with credentials",
+			mode: ChainExclusive,
+			schemes: func(*testing.T) []Scheme {
+				return []Scheme{
+					{"mtls", resolved(&Subject{Roles: []string{"billing"}})},
+					{"jwt", resolved(nil)},
+					{"api-key", resolved(nil)},
+				}
+			},
+			want: &Subject{Roles: []string{"billing"}, Scheme: "mtls"},
+		},
+		{
+			name: "exclusive multiple schemes with credentials",
+			mode: ChainExclusive,
+			schemes: func(*testing.T) []Scheme {
+				return []Scheme{{"mtls", resolved(&Subject{})}, {"jwt", resolved(&Subject{})}}
+			},
+			wantErr: ErrAmbiguousCredentials,
+		},
+		{
+			name: "exclusive valid and invalid credentials",
+			mode: ChainExclusive,
+			schemes: func(*testing.T) []Scheme {
+				return []Scheme{{"mtls", resolved(&Subject{})}, {"jwt", failed(errExpired)}}
+			},
+			wantErr: ErrAmbiguousCredentials,
+		},
+		{
+			name: "exclusive invalid credentials",
+			mode: ChainExclusive,
+			schemes: func(*testing.T) []Scheme {
+				return []Scheme{{"mtls", resolved(nil)}, {"jwt", failed(errExpired)}}
+			},
+			wantErr: errExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject, err := ChainResolvers(tt.mode, tt.schemes(t)...)(context.Background())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, subject)
+		})
+	}
+}
+
+func Test_ChainResolvers_subjectNotModified(t *testing.T) {
+	subject := &Subject{Roles: []string{"user"}}
+
+	resolve := ChainResolvers(ChainFirst, Scheme{"jwt", func(context.Context) (*Subject, error) { return subject, nil }})
+
+	resolved, err := resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "jwt", resolved.Scheme)
+	assert.Empty(t, subject.Scheme)
+}
+
+func Test_interceptor_resolveSubject(t *testing.T) {
+	var (
+		user    = &Subject{Roles: []string{"user"}}
+		service = &Subject{Roles: []string{"billing"}}
+	)
+
+	resolved := func(subject *Subject) SubjectResolver {
+		return func(context.Context) (*Subject, error) { return subject, nil }
+	}
+
+	server := mockGuardServiceProvider{service: &guard.Service{
+		Name:           "Service",
+		Authenticators: []string{"jwt"},
+		Methods: map[string]*guard.Method{
+			"Internal": {Authenticators: []string{"mtls"}},
+			"Legacy":   {Authenticators: []string{"basic"}},
+		},
+	}}
+
+	tests := []struct {
+		name           string
+		server         any
+		fullMethod     string
+		resolver       SubjectResolver
+		authenticators Authenticators
+		want           *Subject
+		wantErr        error
+	}{
+		{
+			name:       "subject resolver without guard service",
+			server:     &struct{}{},
+			fullMethod: "/pkg.Service/Method",
+			resolver:   resolved(user),
+			want:       user,
+		},
+		{
+			name:       "no subject resolver",
+			server:     &struct{}{},
+			fullMethod: "/pkg.Service/Method",
+			want:       nil,
+		},
+		{
+			name:           "service authenticator",
+			server:         server,
+			fullMethod:     "/pkg.Service/Method",
+			resolver:       resolved(service),
+			authenticators: Authenticators{"jwt": resolved(user), "mtls": resolved(service)},
+			want:           &Subject{Roles: []string{"user"}, Scheme: "jwt"},
+		},
+		{
+			name:           "method authenticator",
+			server:         server,
+			fullMethod:     "/pkg.Service/Internal",
+			authenticators: Authenticators{"jwt": resolved(user), "mtls": resolved(service)},
+			want:           &Subject{Roles: []string{"billing"}, Scheme: "mtls"},
+		},
+		{
+			name:           "credentials of not accepted scheme",
+			server:         server,
+			fullMethod:     "/pkg.Service/Internal",
+			resolver:       resolved(user),
+			authenticators: Authenticators{"jwt": resolved(user), "mtls": resolved(nil)},
+			want:           nil,
+		},
+		{
+			name:           "undefined authenticator",
+			server:         server,
+			fullMethod:     "/pkg.Service/Legacy",
+			authenticators: Authenticators{"jwt": resolved(user)},
+			wantErr:        ErrUndefinedAuthenticator,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New(tt.resolver, WithAuthenticators(tt.authenticators))
+
+			subject, err := i.resolveSubject(context.Background(), tt.server, tt.fullMethod)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.NotErrorIs(t, err, ErrInvalidCredentials)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, subject)
+		})
+	}
+}
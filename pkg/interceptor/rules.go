@@ -20,21 +20,93 @@ func (i *Interceptor) getGuardService(server any) *guard.Service {
 	return provider.GuardService()
 }
 
-// getRules returns the effective access rules for a specific gRPC method.
-// It applies the precedence order: method rules → service rules → default rules.
-func (i *Interceptor) getRules(server any, fullMethod string) guard.Rules {
+// getRules returns the effective access rules for a specific gRPC method as groups of rules.
+// Access is granted when every group allows it, i.e. when any rule of each group allows it.
+//
+// Inherited rules follow the precedence order: service rules → file rules → default rules.
+// Method rules, depending on the method inherit mode, replace inherited rules,
+// are appended to them or must allow access in addition to them.
+func (i *Interceptor) getRules(server any, fullMethod string) []guard.Rules {
 	service := i.getGuardService(server)
 	if service == nil {
 		return nil
 	}
 
-	if method, exists := service.Methods[path.Base(fullMethod)]; exists && method.Rules != nil {
-		return method.Rules
+	inherited := i.defaultRules
+	if service.Rules != nil {
+		inherited = service.Rules
+	} else if service.FileRules != nil {
+		inherited = service.FileRules
 	}
 
-	if service.Rules != nil {
-		return service.Rules
+	method, exists := service.Methods[path.Base(fullMethod)]
+	if !exists || method.Rules == nil {
+		return []guard.Rules{inherited}
+	}
+
+	switch method.Inherit {
+	case guard.InheritAppend:
+		rules := make(guard.Rules, 0, len(inherited)+len(method.Rules))
+		rules = append(rules, inherited...)
+
+		return []guard.Rules{append(rules, method.Rules...)}
+
+	case guard.InheritIntersect:
+		return []guard.Rules{inherited, method.Rules}
+
+	default:
+		return []guard.Rules{method.Rules}
+	}
+}
+
+// getDenyRules returns the deny rules for a specific gRPC method.
+// Unlike allow rules, service deny rules are not overridden: method deny rules are added to them.
+func (i *Interceptor) getDenyRules(server any, fullMethod string) guard.Rules {
+	service := i.getGuardService(server)
+	if service == nil {
+		return nil
+	}
+
+	method, exists := service.Methods[path.Base(fullMethod)]
+	if !exists || len(method.DenyRules) == 0 {
+		return service.DenyRules
+	}
+
+	if len(service.DenyRules) == 0 {
+		return method.DenyRules
+	}
+
+	rules := make(guard.Rules, 0, len(service.DenyRules)+len(method.DenyRules))
+	rules = append(rules, service.DenyRules...)
+
+	return append(rules, method.DenyRules...)
+}
+
+// getAuthenticators returns the names of the authenticators accepted for a specific gRPC method:
+// method authenticators replace service authenticators. Returns nil if neither declares any.
+func (i *Interceptor) getAuthenticators(server any, fullMethod string) []string {
+	service := i.getGuardService(server)
+	if service == nil {
+		return nil
+	}
+
+	if method, exists := service.Methods[path.Base(fullMethod)]; exists && len(method.Authenticators) > 0 {
+		return method.Authenticators
+	}
+
+	return service.Authenticators
+}
+
+// getDenial returns the status declared for denials of a specific gRPC method.
+func (i *Interceptor) getDenial(server any, fullMethod string) *guard.Denial {
+	service := i.getGuardService(server)
+	if service == nil {
+		return nil
+	}
+
+	if method, exists := service.Methods[path.Base(fullMethod)]; exists {
+		return method.Denial
 	}
 
-	return i.defaultRules
+	return nil
 }
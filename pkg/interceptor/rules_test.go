@@ -70,7 +70,7 @@ func Test_interceptor_getRules(t *testing.T) {
 		Service      *guard.Service
 		defaultRules guard.Rules
 		fullMethod   string
-		want         guard.Rules
+		want         []guard.Rules
 	}{
 		{
 			Name:         "nil service with nil default rules returns nil",
@@ -87,7 +87,7 @@ func Test_interceptor_getRules(t *testing.T) {
 			},
 			fullMethod:   "/pkg.Service/Method",
 			defaultRules: guard.Rules{data.allowPublicRule},
-			want:         guard.Rules{data.allowPublicRule},
+			want:         []guard.Rules{{data.allowPublicRule}},
 		},
 		{
 			Name: "method rules take precedence over service rules",
@@ -100,7 +100,7 @@ func Test_interceptor_getRules(t *testing.T) {
 			},
 			fullMethod:   "/pkg.Service/Method",
 			defaultRules: nil,
-			want:         guard.Rules{data.allowPublicRule},
+			want:         []guard.Rules{{data.allowPublicRule}},
 		},
 		{
 			Name: "service rules used when no method rules exist",
@@ -111,7 +111,30 @@ func Test_interceptor_getRules(t *testing.T) {
 			},
 			fullMethod:   "/pkg.Service/Method",
 			defaultRules: nil,
-			want:         guard.Rules{data.requireAuthRule},
+			want:         []guard.Rules{{data.requireAuthRule}},
+		},
+		{
+			Name: "file rules used when no method or service rules exist",
+			Service: &guard.Service{
+				Name:      "Service",
+				FileRules: guard.Rules{data.authenticatedAccessRule},
+				Methods:   map[string]*guard.Method{},
+			},
+			fullMethod:   "/pkg.Service/Method",
+			defaultRules: guard.Rules{data.allowPublicRule},
+			want:         []guard.Rules{{data.authenticatedAccessRule}},
+		},
+		{
+			Name: "service rules take precedence over file rules",
+			Service: &guard.Service{
+				Name:      "Service",
+				Rules:     guard.Rules{data.requireAuthRule},
+				FileRules: guard.Rules{data.authenticatedAccessRule},
+				Methods:   map[string]*guard.Method{},
+			},
+			fullMethod:   "/pkg.Service/Method",
+			defaultRules: nil,
+			want:         []guard.Rules{{data.requireAuthRule}},
 		},
 		{
 			Name: "empty method rules override service rules",
@@ -124,7 +147,45 @@ func Test_interceptor_getRules(t *testing.T) {
 			},
 			fullMethod:   "/pkg.Service/Method",
 			defaultRules: guard.Rules{data.allowPublicRule},
-			want:         guard.Rules{{}},
+			want:         []guard.Rules{{{}}},
+		},
+		{
+			Name: "append inherit mode adds method rules to service rules",
+			Service: &guard.Service{
+				Name:  "Service",
+				Rules: guard.Rules{data.requireAuthRule},
+				Methods: map[string]*guard.Method{
+					"Method": {Rules: guard.Rules{data.allowPublicRule}, Inherit: guard.InheritAppend},
+				},
+			},
+			fullMethod:   "/pkg.Service/Method",
+			defaultRules: nil,
+			want:         []guard.Rules{{data.requireAuthRule, data.allowPublicRule}},
+		},
+		{
+			Name: "append inherit mode adds method rules to default rules",
+			Service: &guard.Service{
+				Name: "Service",
+				Methods: map[string]*guard.Method{
+					"Method": {Rules: guard.Rules{data.authenticatedAccessRule}, Inherit: guard.InheritAppend},
+				},
+			},
+			fullMethod:   "/pkg.Service/Method",
+			defaultRules: guard.Rules{data.requireAuthRule},
+			want:         []guard.Rules{{data.requireAuthRule, data.authenticatedAccessRule}},
+		},
+		{
+			Name: "intersect inherit mode requires both file and method rules",
+			Service: &guard.Service{
+				Name:      "Service",
+				FileRules: guard.Rules{data.requireAuthRule},
+				Methods: map[string]*guard.Method{
+					"Method": {Rules: guard.Rules{data.authenticatedAccessRule}, Inherit: guard.InheritIntersect},
+				},
+			},
+			fullMethod:   "/pkg.Service/Method",
+			defaultRules: guard.Rules{data.allowPublicRule},
+			want:         []guard.Rules{{data.requireAuthRule}, {data.authenticatedAccessRule}},
 		},
 		{
 			Name:         "nil service returns nil even with default rules",
@@ -151,3 +212,217 @@ func Test_interceptor_getRules(t *testing.T) {
 		})
 	}
 }
+
+func Test_interceptor_getDenyRules(t *testing.T) {
+	data := struct {
+		suspendedRule *guard.Rule
+		lockoutRule   *guard.Rule
+	}{
+		suspendedRule: &guard.Rule{
+			AuthenticatedAccess: &guard.AuthenticatedAccess{
+				RoleBased: &guard.RoleBased{
+					Roles:       []string{"suspended"},
+					Requirement: guard.RequirementAtLeastOne,
+				},
+			},
+		},
+		lockoutRule: &guard.Rule{AllowPublic: guard.Ptr(true)},
+	}
+
+	tests := []struct {
+		Name       string
+		Service    *guard.Service
+		fullMethod string
+		want       guard.Rules
+	}{
+		{
+			Name:       "nil service returns nil",
+			Service:    nil,
+			fullMethod: "/pkg.Service/Method",
+			want:       nil,
+		},
+		{
+			Name: "service deny rules apply to methods with allow rules",
+			Service: &guard.Service{
+				Name:      "Service",
+				DenyRules: guard.Rules{data.suspendedRule},
+				Methods: map[string]*guard.Method{
+					"Method": {Rules: guard.Rules{{AllowPublic: guard.Ptr(true)}}},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       guard.Rules{data.suspendedRule},
+		},
+		{
+			Name: "method deny rules without service deny rules",
+			Service: &guard.Service{
+				Name: "Service",
+				Methods: map[string]*guard.Method{
+					"Method": {DenyRules: guard.Rules{data.lockoutRule}},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       guard.Rules{data.lockoutRule},
+		},
+		{
+			Name: "service and method deny rules accumulate",
+			Service: &guard.Service{
+				Name:      "Service",
+				DenyRules: guard.Rules{data.suspendedRule},
+				Methods: map[string]*guard.Method{
+					"Method": {DenyRules: guard.Rules{data.lockoutRule}},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       guard.Rules{data.suspendedRule, data.lockoutRule},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			var server any
+			if tt.Service != nil {
+				server = mockGuardServiceProvider{service: tt.Service}
+			} else {
+				server = &struct{}{}
+			}
+
+			i := &Interceptor{}
+
+			got := i.getDenyRules(server, tt.fullMethod)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_interceptor_getDenial(t *testing.T) {
+	notFound := &guard.Denial{Code: 5}
+
+	tests := []struct {
+		Name       string
+		Service    *guard.Service
+		fullMethod string
+		want       *guard.Denial
+	}{
+		{
+			Name:       "nil service returns nil",
+			Service:    nil,
+			fullMethod: "/pkg.Service/Method",
+			want:       nil,
+		},
+		{
+			Name: "method without denial returns nil",
+			Service: &guard.Service{
+				Name: "Service",
+				Methods: map[string]*guard.Method{
+					"Method": {Rules: guard.Rules{{AllowPublic: guard.Ptr(true)}}},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       nil,
+		},
+		{
+			Name: "method denial",
+			Service: &guard.Service{
+				Name: "Service",
+				Methods: map[string]*guard.Method{
+					"Method": {Denial: notFound},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       notFound,
+		},
+		{
+			Name: "denial of another method is not used",
+			Service: &guard.Service{
+				Name: "Service",
+				Methods: map[string]*guard.Method{
+					"Other": {Denial: notFound},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			var server any
+			if tt.Service != nil {
+				server = mockGuardServiceProvider{service: tt.Service}
+			} else {
+				server = &struct{}{}
+			}
+
+			i := &Interceptor{}
+
+			assert.Equal(t, tt.want, i.getDenial(server, tt.fullMethod))
+		})
+	}
+}
+
+func Test_interceptor_getAuthenticators(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Service    *guard.Service
+		fullMethod string
+		want       []string
+	}{
+		{
+			Name:       "nil service returns nil",
+			Service:    nil,
+			fullMethod: "/pkg.Service/Method",
+			want:       nil,
+		},
+		{
+			Name: "no authenticators returns nil",
+			Service: &guard.Service{
+				Name: "Service",
+				Methods: map[string]*guard.Method{
+					"Method": {Rules: guard.Rules{{AllowPublic: guard.Ptr(true)}}},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       nil,
+		},
+		{
+			Name: "service authenticators used when method has none",
+			Service: &guard.Service{
+				Name:           "Service",
+				Authenticators: []string{"jwt", "api-key"},
+				Methods: map[string]*guard.Method{
+					"Method": {Rules: guard.Rules{{AllowPublic: guard.Ptr(true)}}},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       []string{"jwt", "api-key"},
+		},
+		{
+			Name: "method authenticators replace service authenticators",
+			Service: &guard.Service{
+				Name:           "Service",
+				Authenticators: []string{"jwt", "api-key"},
+				Methods: map[string]*guard.Method{
+					"Method": {Authenticators: []string{"mtls"}},
+				},
+			},
+			fullMethod: "/pkg.Service/Method",
+			want:       []string{"mtls"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			var server any
+			if tt.Service != nil {
+				server = mockGuardServiceProvider{service: tt.Service}
+			} else {
+				server = &struct{}{}
+			}
+
+			i := &Interceptor{}
+
+			assert.Equal(t, tt.want, i.getAuthenticators(server, tt.fullMethod))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package interceptor
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+// evaluateStepUp checks the authentication level and freshness required by the access.
+// Returns a denying result for the first requirement the subject does not meet, or nil if it meets all of them.
+// The details of the result hold the required level, or the maximum age in seconds.
+func (i *Interceptor) evaluateStepUp(access *guard.AuthenticatedAccess, subject *Subject) *EvaluationResult {
+	if access.MinAuthLevel > 0 && subject.AuthLevel < access.MinAuthLevel {
+		return &EvaluationResult{
+			Allowed: false,
+			Rule:    RuleKindAuthLevel,
+			Details: []string{strconv.Itoa(access.MinAuthLevel)},
+		}
+	}
+
+	if access.MaxAuthAge > 0 && (subject.AuthenticatedAt.IsZero() || i.now().Sub(subject.AuthenticatedAt) > access.MaxAuthAge) {
+		return &EvaluationResult{
+			Allowed: false,
+			Rule:    RuleKindAuthAge,
+			Details: []string{strconv.FormatInt(int64(access.MaxAuthAge/time.Second), 10)},
+		}
+	}
+
+	return nil
+}
+
+// stepUpKind returns the kind of the last step-up requirement of the access.
+// Returns false if the access has none.
+func stepUpKind(access *guard.AuthenticatedAccess) (RuleKind, bool) {
+	switch {
+	case access.MaxAuthAge > 0:
+		return RuleKindAuthAge, true
+	case access.MinAuthLevel > 0:
+		return RuleKindAuthLevel, true
+	default:
+		return "", false
+	}
+}
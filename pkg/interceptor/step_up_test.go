@@ -0,0 +1,73 @@
+package interceptor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_interceptor_evaluateStepUp(t *testing.T) {
+	now := time.Date(2025, time.March, 5, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		access  *guard.AuthenticatedAccess
+		subject *Subject
+		want    *EvaluationResult
+	}{
+		{
+			name:    "no step-up requirements",
+			access:  &guard.AuthenticatedAccess{},
+			subject: &Subject{},
+			want:    nil,
+		},
+		{
+			name:    "sufficient auth level",
+			access:  &guard.AuthenticatedAccess{MinAuthLevel: 2},
+			subject: &Subject{AuthLevel: 3},
+			want:    nil,
+		},
+		{
+			name:    "insufficient auth level",
+			access:  &guard.AuthenticatedAccess{MinAuthLevel: 2},
+			subject: &Subject{AuthLevel: 1},
+			want:    &EvaluationResult{Allowed: false, Rule: RuleKindAuthLevel, Details: []string{"2"}},
+		},
+		{
+			name:    "recent authentication",
+			access:  &guard.AuthenticatedAccess{MaxAuthAge: 5 * time.Minute},
+			subject: &Subject{AuthenticatedAt: now.Add(-5 * time.Minute)},
+			want:    nil,
+		},
+		{
+			name:    "old authentication",
+			access:  &guard.AuthenticatedAccess{MaxAuthAge: 5 * time.Minute},
+			subject: &Subject{AuthenticatedAt: now.Add(-5*time.Minute - time.Second)},
+			want:    &EvaluationResult{Allowed: false, Rule: RuleKindAuthAge, Details: []string{"300"}},
+		},
+		{
+			name:    "unknown authentication time",
+			access:  &guard.AuthenticatedAccess{MaxAuthAge: 5 * time.Minute},
+			subject: &Subject{},
+			want:    &EvaluationResult{Allowed: false, Rule: RuleKindAuthAge, Details: []string{"300"}},
+		},
+		{
+			name:    "auth level checked before authentication age",
+			access:  &guard.AuthenticatedAccess{MinAuthLevel: 2, MaxAuthAge: 5 * time.Minute},
+			subject: &Subject{AuthLevel: 1},
+			want:    &EvaluationResult{Allowed: false, Rule: RuleKindAuthLevel, Details: []string{"2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &Interceptor{
+				clock: func() time.Time { return now },
+			}
+
+			assert.Equal(t, tt.want, i.evaluateStepUp(tt.access, tt.subject))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// authorizedServerStream is a grpc.ServerStream of an authorized request.
+// Its context carries the authorization, see SubjectFromContext. Response fields
+// the subject is not allowed to see are cleared from sent messages, and received messages
+// setting fields the subject is not allowed to write are rejected.
+type authorizedServerStream struct {
+	grpc.ServerStream
+
+	ctx         context.Context
+	interceptor *Interceptor
+	server      any
+	fullMethod  string
+	input       *Input
+}
+
+func (s *authorizedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg sends a redacted copy of the message, leaving the message itself intact.
+// The message is not sent if redaction fails.
+func (s *authorizedServerStream) SendMsg(m any) error {
+	redacted, err := s.interceptor.redactResponse(s.ctx, s.server, s.fullMethod, m, s.input)
+	if err != nil {
+		return err
+	}
+
+	return s.ServerStream.SendMsg(redacted)
+}
+
+// RecvMsg receives a message and checks its fields against write rules. A message setting
+// a field the subject is not allowed to write is not passed on: a gRPC error is returned instead.
+func (s *authorizedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.interceptor.checkWriteRules(s.ctx, s.server, s.fullMethod, m, s.input)
+}
@@ -0,0 +1,172 @@
+package interceptor
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+)
+
+type mockServerStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	sent     []any
+	received []proto.Message
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockServerStream) SendMsg(msg any) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func (m *mockServerStream) RecvMsg(msg any) error {
+	if len(m.received) == 0 {
+		return io.EOF
+	}
+
+	proto.Merge(msg.(proto.Message), m.received[0])
+	m.received = m.received[1:]
+
+	return nil
+}
+
+func Test_authorizedServerStream_SendMsg(t *testing.T) {
+	server := mockGuardServiceProvider{service: &guard.Service{
+		Name:  "Service",
+		Rules: guard.Rules{{AllowPublic: guard.Ptr(true)}},
+		Messages: map[string]*guard.Message{
+			"google.protobuf.SourceContext": {
+				Fields: map[string]*guard.Field{
+					"file_name": {Rules: guard.Rules{{RequireAuthentication: guard.Ptr(true)}}},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name    string
+		subject *Subject
+		want    string
+	}{
+		{
+			name:    "visible field is sent",
+			subject: &Subject{},
+			want:    "api.proto",
+		},
+		{
+			name:    "hidden field is cleared",
+			subject: nil,
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New(func(context.Context) (*Subject, error) { return tt.subject, nil })
+			ss := &mockServerStream{ctx: context.Background()}
+			msg := &sourcecontextpb.SourceContext{FileName: "api.proto"}
+
+			info := &grpc.StreamServerInfo{FullMethod: "/pkg.Service/Watch"}
+			err := i.Stream()(server, ss, info, func(_ any, stream grpc.ServerStream) error {
+				return stream.SendMsg(msg)
+			})
+			require.NoError(t, err)
+
+			require.Len(t, ss.sent, 1)
+			assert.Equal(t, tt.want, ss.sent[0].(*sourcecontextpb.SourceContext).GetFileName())
+			assert.Equal(t, "api.proto", msg.GetFileName())
+		})
+	}
+}
+
+func Test_authorizedServerStream_SendMsg_evaluationError(t *testing.T) {
+	server := mockGuardServiceProvider{service: &guard.Service{
+		Name:  "Service",
+		Rules: guard.Rules{{AllowPublic: guard.Ptr(true)}},
+		Messages: map[string]*guard.Message{
+			"google.protobuf.SourceContext": {
+				Fields: map[string]*guard.Field{
+					"file_name": {Rules: guard.Rules{{
+						AuthenticatedAccess: &guard.AuthenticatedAccess{
+							PolicyBased: &guard.PolicyBased{Policies: []string{"undefined"}},
+						},
+					}}},
+				},
+			},
+		},
+	}}
+
+	i := New(func(context.Context) (*Subject, error) { return &Subject{}, nil })
+	ss := &mockServerStream{ctx: context.Background()}
+
+	info := &grpc.StreamServerInfo{FullMethod: "/pkg.Service/Watch"}
+	err := i.Stream()(server, ss, info, func(_ any, stream grpc.ServerStream) error {
+		return stream.SendMsg(&sourcecontextpb.SourceContext{FileName: "api.proto"})
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Empty(t, ss.sent)
+}
+
+func Test_authorizedServerStream_RecvMsg(t *testing.T) {
+	server := mockGuardServiceProvider{service: &guard.Service{
+		Name:  "Service",
+		Rules: guard.Rules{{AllowPublic: guard.Ptr(true)}},
+		Messages: map[string]*guard.Message{
+			"google.protobuf.SourceContext": {
+				Fields: map[string]*guard.Field{
+					"file_name": {WriteRules: guard.Rules{{RequireAuthentication: guard.Ptr(true)}}},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name         string
+		subject      *Subject
+		expectedCode codes.Code
+	}{
+		{
+			name:         "writable field is received",
+			subject:      &Subject{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "protected field is rejected",
+			subject:      nil,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New(func(context.Context) (*Subject, error) { return tt.subject, nil })
+			ss := &mockServerStream{
+				ctx:      context.Background(),
+				received: []proto.Message{&sourcecontextpb.SourceContext{FileName: "api.proto"}},
+			}
+
+			var received sourcecontextpb.SourceContext
+
+			info := &grpc.StreamServerInfo{FullMethod: "/pkg.Service/Upload"}
+			err := i.Stream()(server, ss, info, func(_ any, stream grpc.ServerStream) error {
+				return stream.RecvMsg(&received)
+			})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
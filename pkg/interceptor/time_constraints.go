@@ -0,0 +1,101 @@
+package interceptor
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+)
+
+// ruleActive reports whether the current time satisfies the time constraints of the rule:
+// it is within the validity period and, if the rule has windows, within one of them.
+func (i *Interceptor) ruleActive(rule *guard.Rule) (bool, error) {
+	if rule.ValidFrom == nil && rule.ValidUntil == nil && len(rule.Windows) == 0 {
+		return true, nil
+	}
+
+	now := i.now()
+
+	if rule.ValidFrom != nil && now.Before(*rule.ValidFrom) {
+		return false, nil
+	}
+
+	if rule.ValidUntil != nil && !now.Before(*rule.ValidUntil) {
+		return false, nil
+	}
+
+	if len(rule.Windows) == 0 {
+		return true, nil
+	}
+
+	for _, window := range rule.Windows {
+		within, err := i.withinTimeWindow(window, now)
+		if err != nil {
+			return false, err
+		}
+
+		if within {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// withinTimeWindow reports whether the time falls within the window, in the window time zone.
+// A window ending before it starts wraps past midnight: its weekdays are the days it opens on,
+// so after midnight the previous day must be one of them.
+func (i *Interceptor) withinTimeWindow(window *guard.TimeWindow, now time.Time) (bool, error) {
+	location, err := i.loadLocation(window.TimeZone)
+	if err != nil {
+		return false, err
+	}
+
+	local := now.In(location)
+
+	offset := time.Duration(local.Hour())*time.Hour +
+		time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second +
+		time.Duration(local.Nanosecond())
+
+	openedOn := local.Weekday()
+
+	switch {
+	case window.Start < window.End:
+		if offset < window.Start || offset >= window.End {
+			return false, nil
+		}
+	case offset >= window.Start:
+	case offset < window.End:
+		openedOn = (openedOn + 6) % 7
+	default:
+		return false, nil
+	}
+
+	return len(window.Weekdays) == 0 || slices.Contains(window.Weekdays, openedOn), nil
+}
+
+// loadLocation returns the time zone by its IANA name, caching loaded time zones.
+func (i *Interceptor) loadLocation(name string) (*time.Location, error) {
+	if cached, ok := i.locations.Load(name); ok {
+		return cached.(*time.Location), nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("time window: %w", err)
+	}
+
+	i.locations.Store(name, location)
+
+	return location, nil
+}
+
+func (i *Interceptor) now() time.Time {
+	if i.clock != nil {
+		return i.clock()
+	}
+
+	return time.Now()
+}
@@ -0,0 +1,144 @@
+package interceptor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_interceptor_ruleActive(t *testing.T) {
+	// Wednesday, 10:30 UTC; 11:30 in Berlin.
+	now := time.Date(2025, time.March, 5, 10, 30, 0, 0, time.UTC)
+
+	businessHours := &guard.TimeWindow{
+		Weekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		Start:    9 * time.Hour,
+		End:      18 * time.Hour,
+	}
+
+	tests := []struct {
+		name string
+		rule *guard.Rule
+
+		want         bool
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "rule without time constraints",
+			rule: &guard.Rule{},
+			want: true,
+		},
+		{
+			name: "within validity period",
+			rule: &guard.Rule{ValidFrom: guard.Ptr(now.Add(-time.Hour)), ValidUntil: guard.Ptr(now.Add(time.Hour))},
+			want: true,
+		},
+		{
+			name: "valid from is inclusive",
+			rule: &guard.Rule{ValidFrom: guard.Ptr(now)},
+			want: true,
+		},
+		{
+			name: "valid until is exclusive",
+			rule: &guard.Rule{ValidUntil: guard.Ptr(now)},
+			want: false,
+		},
+		{
+			name: "before validity period",
+			rule: &guard.Rule{ValidFrom: guard.Ptr(now.Add(time.Hour))},
+			want: false,
+		},
+		{
+			name: "within business hours window",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{businessHours}},
+			want: true,
+		},
+		{
+			name: "outside of window weekdays",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{Weekdays: []time.Weekday{time.Saturday, time.Sunday}, End: 24 * time.Hour},
+			}},
+			want: false,
+		},
+		{
+			name: "outside of window hours",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{Start: 11 * time.Hour, End: 12 * time.Hour},
+			}},
+			want: false,
+		},
+		{
+			name: "within window hours in window time zone",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{Start: 11 * time.Hour, End: 12 * time.Hour, TimeZone: "Europe/Berlin"},
+			}},
+			want: true,
+		},
+		{
+			name: "one of windows matches",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{Start: 0, End: time.Hour},
+				{Start: 10*time.Hour + 30*time.Minute, End: 11 * time.Hour},
+			}},
+			want: true,
+		},
+		{
+			name: "overnight window after midnight of listed day",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{Weekdays: []time.Weekday{time.Tuesday}, Start: 22 * time.Hour, End: 11 * time.Hour},
+			}},
+			want: true,
+		},
+		{
+			name: "overnight window before opening on listed day",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{Weekdays: []time.Weekday{time.Wednesday}, Start: 22 * time.Hour, End: 11 * time.Hour},
+			}},
+			want: false,
+		},
+		{
+			name: "overnight window before midnight",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{Weekdays: []time.Weekday{time.Wednesday}, Start: 10 * time.Hour, End: 2 * time.Hour},
+			}},
+			want: true,
+		},
+		{
+			name: "outside of overnight window hours",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{Start: 22 * time.Hour, End: 6 * time.Hour},
+			}},
+			want: false,
+		},
+		{
+			name: "within window but after validity period",
+			rule: &guard.Rule{ValidUntil: guard.Ptr(now.Add(-time.Minute)), Windows: []*guard.TimeWindow{businessHours}},
+			want: false,
+		},
+		{
+			name: "unknown time zone",
+			rule: &guard.Rule{Windows: []*guard.TimeWindow{
+				{End: 24 * time.Hour, TimeZone: "Mars/Olympus_Mons"},
+			}},
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New(nil, WithClock(func() time.Time { return now }))
+
+			got, err := i.ruleActive(tt.rule)
+			if tt.errAssertion != nil {
+				tt.errAssertion(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
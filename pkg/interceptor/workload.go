@@ -0,0 +1,73 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/x509"
+	"slices"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/casnerano/protoc-gen-go-guard/pkg/spiffe"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// peerCertificate returns the verified client certificate of the request,
+// or nil if the connection is not mutually authenticated with TLS.
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0]
+}
+
+// workloadAllowed checks if the client certificate matches any identity of the workload.
+func workloadAllowed(workload *guard.Workload, cert *x509.Certificate) bool {
+	if cert == nil {
+		return false
+	}
+
+	if id, ok := spiffe.ID(cert); ok {
+		for _, pattern := range workload.SPIFFEIDs {
+			if spiffe.Match(pattern, id) {
+				return true
+			}
+		}
+	}
+
+	if cert.Subject.CommonName != "" && slices.Contains(workload.CommonNames, cert.Subject.CommonName) {
+		return true
+	}
+
+	for _, pattern := range workload.DNSNames {
+		for _, dnsName := range cert.DNSNames {
+			if matchDNSName(pattern, dnsName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchDNSName matches a DNS name case-insensitively against a name
+// or a wildcard pattern like "*.example.com" matching a single label.
+func matchDNSName(pattern, name string) bool {
+	pattern, name = strings.ToLower(pattern), strings.ToLower(name)
+
+	suffix, wildcard := strings.CutPrefix(pattern, "*.")
+	if !wildcard {
+		return pattern == name
+	}
+
+	label, rest, found := strings.Cut(name, ".")
+
+	return found && label != "" && rest == suffix
+}
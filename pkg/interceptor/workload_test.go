@@ -0,0 +1,183 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func Test_workloadAllowed(t *testing.T) {
+	worker := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "billing-worker"},
+		DNSNames: []string{"worker.billing.svc.cluster.local"},
+		URIs:     []*url.URL{{Scheme: "spiffe", Host: "prod.example.com", Path: "/ns/billing/sa/worker"}},
+	}
+
+	tests := []struct {
+		name     string
+		workload *guard.Workload
+		cert     *x509.Certificate
+		want     bool
+	}{
+		{
+			name:     "without certificate",
+			workload: &guard.Workload{SPIFFEIDs: []string{"spiffe://prod.example.com/**"}},
+			want:     false,
+		},
+		{
+			name:     "matching spiffe id",
+			workload: &guard.Workload{SPIFFEIDs: []string{"spiffe://prod.example.com/ns/web/**", "spiffe://prod.example.com/ns/billing/**"}},
+			cert:     worker,
+			want:     true,
+		},
+		{
+			name:     "other spiffe id",
+			workload: &guard.Workload{SPIFFEIDs: []string{"spiffe://prod.example.com/ns/web/**"}},
+			cert:     worker,
+			want:     false,
+		},
+		{
+			name:     "matching common name",
+			workload: &guard.Workload{CommonNames: []string{"billing-worker"}},
+			cert:     worker,
+			want:     true,
+		},
+		{
+			name:     "other common name",
+			workload: &guard.Workload{CommonNames: []string{"billing-api"}},
+			cert:     worker,
+			want:     false,
+		},
+		{
+			name:     "matching dns name",
+			workload: &guard.Workload{DNSNames: []string{"*.billing.svc.cluster.local"}},
+			cert:     worker,
+			want:     true,
+		},
+		{
+			name:     "other dns name",
+			workload: &guard.Workload{DNSNames: []string{"*.web.svc.cluster.local"}},
+			cert:     worker,
+			want:     false,
+		},
+		{
+			name:     "any identity matches",
+			workload: &guard.Workload{SPIFFEIDs: []string{"spiffe://prod.example.com/ns/web/**"}, CommonNames: []string{"billing-worker"}},
+			cert:     worker,
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, workloadAllowed(tt.workload, tt.cert))
+		})
+	}
+}
+
+func Test_matchDNSName(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		dnsName string
+		want    bool
+	}{
+		{
+			name:    "exact name",
+			pattern: "api.example.com",
+			dnsName: "api.example.com",
+			want:    true,
+		},
+		{
+			name:    "case-insensitive name",
+			pattern: "API.example.com",
+			dnsName: "api.EXAMPLE.com",
+			want:    true,
+		},
+		{
+			name:    "other name",
+			pattern: "api.example.com",
+			dnsName: "web.example.com",
+			want:    false,
+		},
+		{
+			name:    "wildcard",
+			pattern: "*.example.com",
+			dnsName: "api.example.com",
+			want:    true,
+		},
+		{
+			name:    "wildcard matches single label",
+			pattern: "*.example.com",
+			dnsName: "v1.api.example.com",
+			want:    false,
+		},
+		{
+			name:    "wildcard does not match suffix",
+			pattern: "*.example.com",
+			dnsName: "example.com",
+			want:    false,
+		},
+		{
+			name:    "wildcard does not match empty label",
+			pattern: "*.example.com",
+			dnsName: ".example.com",
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchDNSName(tt.pattern, tt.dnsName))
+		})
+	}
+}
+
+func Test_peerCertificate(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing-worker"}}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want *x509.Certificate
+	}{
+		{
+			name: "without peer",
+			ctx:  context.Background(),
+		},
+		{
+			name: "without tls",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{}),
+		},
+		{
+			name: "unverified certificate",
+			ctx: peer.NewContext(context.Background(), &peer.Peer{
+				AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+			}),
+		},
+		{
+			name: "verified certificate",
+			ctx: peer.NewContext(context.Background(), &peer.Peer{
+				AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{cert},
+					VerifiedChains:   [][]*x509.Certificate{{cert}},
+				}},
+			}),
+			want: cert,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Same(t, tt.want, peerCertificate(tt.ctx))
+		})
+	}
+}
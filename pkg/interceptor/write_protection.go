@@ -0,0 +1,298 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var fieldMaskFullName = (&fieldmaskpb.FieldMask{}).ProtoReflect().Descriptor().FullName()
+
+// checkWriteRules rejects the request if it sets a field the subject is not allowed to write.
+// Returns nil on success, or a gRPC error naming the offending field path on denial/failure.
+//
+// Without a google.protobuf.FieldMask in the request, every populated field is considered written.
+// When the request carries a non-empty field mask, only masked paths are considered written,
+// including masked fields that are being cleared. Mask paths are resolved against the request
+// and, failing that, against its top-level message fields (the updated resource).
+func (i *Interceptor) checkWriteRules(ctx context.Context, server any, fullMethod string, req any, input *Input) error {
+	service := i.getGuardService(server)
+	if service == nil || len(service.Messages) == 0 {
+		return nil
+	}
+
+	message, ok := req.(proto.Message)
+	if !ok || message == nil {
+		return nil
+	}
+
+	w := writeChecker{
+		interceptor: i,
+		messages:    service.Messages,
+		input:       input,
+		decisions:   make(map[*guard.Field]bool),
+	}
+
+	fieldPath, err := w.check(ctx, message.ProtoReflect())
+	if err != nil {
+		if i.debug {
+			log.Printf("Write rules evaluation error for %s: %v", fullMethod, err)
+		}
+
+		if i.eventHandlers.OnError != nil {
+			i.eventHandlers.OnError(ctx, input, err)
+		}
+
+		return status.Error(codes.Internal, "evaluation error")
+	}
+
+	if fieldPath == "" {
+		return nil
+	}
+
+	result := &EvaluationResult{
+		Allowed: false,
+		Rule:    RuleKindWriteProtected,
+		Details: []string{fieldPath},
+	}
+
+	if i.debug {
+		log.Printf("Access denied for %s: %s", fullMethod, result.String())
+	}
+
+	if i.eventHandlers.OnAccessDenied != nil {
+		i.eventHandlers.OnAccessDenied(ctx, input, result)
+	}
+
+	st := status.Newf(codes.PermissionDenied, "field %q is not writable", fieldPath)
+
+	return deniedStatus(st, result, i.errorDomain).Err()
+}
+
+// writeChecker walks a request message and finds fields the subject is not allowed to set.
+type writeChecker struct {
+	interceptor *Interceptor
+	messages    map[string]*guard.Message
+	input       *Input
+	decisions   map[*guard.Field]bool
+}
+
+// check returns the path of the first written field denied by its write rules, or an empty string.
+func (w *writeChecker) check(ctx context.Context, request protoreflect.Message) (string, error) {
+	mask := findFieldMask(request)
+	if mask == nil || len(mask.GetPaths()) == 0 {
+		return w.checkMessage(ctx, request, "")
+	}
+
+	for _, maskPath := range mask.GetPaths() {
+		if maskPath == "*" {
+			return w.checkMessage(ctx, request, "")
+		}
+
+		fieldPath, err := w.checkPath(ctx, request, resolveMaskPath(request.Descriptor(), maskPath))
+		if err != nil || fieldPath != "" {
+			return fieldPath, err
+		}
+	}
+
+	return "", nil
+}
+
+// checkMessage checks every populated field of the message and its nested messages.
+func (w *writeChecker) checkMessage(ctx context.Context, message protoreflect.Message, prefix string) (string, error) {
+	if _, exists := w.messages[string(message.Descriptor().FullName())]; !exists {
+		return "", nil
+	}
+
+	var (
+		deniedPath string
+		err        error
+	)
+
+	message.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Message() != nil && fd.Message().FullName() == fieldMaskFullName {
+			return true
+		}
+
+		fieldPath := joinFieldPath(prefix, string(fd.Name()))
+
+		var allowed bool
+		if allowed, err = w.allowed(ctx, message.Descriptor(), fd); err != nil {
+			return false
+		}
+
+		if !allowed {
+			deniedPath = fieldPath
+			return false
+		}
+
+		deniedPath, err = w.checkValue(ctx, fd, value, fieldPath)
+		return err == nil && deniedPath == ""
+	})
+
+	return deniedPath, err
+}
+
+// checkValue descends into singular, repeated and map message values.
+func (w *writeChecker) checkValue(ctx context.Context, fd protoreflect.FieldDescriptor, value protoreflect.Value, fieldPath string) (string, error) {
+	var (
+		deniedPath string
+		err        error
+	)
+
+	switch {
+	case fd.IsMap():
+		if fd.MapValue().Message() == nil {
+			return "", nil
+		}
+
+		value.Map().Range(func(key protoreflect.MapKey, mapValue protoreflect.Value) bool {
+			deniedPath, err = w.checkMessage(ctx, mapValue.Message(), fmt.Sprintf("%s[%v]", fieldPath, key.Interface()))
+			return err == nil && deniedPath == ""
+		})
+
+	case fd.IsList():
+		if fd.Message() == nil {
+			return "", nil
+		}
+
+		list := value.List()
+		for j := 0; j < list.Len() && err == nil && deniedPath == ""; j++ {
+			deniedPath, err = w.checkMessage(ctx, list.Get(j).Message(), fmt.Sprintf("%s[%d]", fieldPath, j))
+		}
+
+	case fd.Message() != nil:
+		deniedPath, err = w.checkMessage(ctx, value.Message(), fieldPath)
+	}
+
+	return deniedPath, err
+}
+
+// checkPath checks every field along a masked path, and everything populated below its last field.
+// Unknown paths are ignored: validating field masks is up to the handler.
+func (w *writeChecker) checkPath(ctx context.Context, request protoreflect.Message, maskPath string) (string, error) {
+	var (
+		message   = request
+		fieldPath string
+	)
+
+	segments := strings.Split(maskPath, ".")
+	for idx, segment := range segments {
+		fd := message.Descriptor().Fields().ByName(protoreflect.Name(segment))
+		if fd == nil {
+			return "", nil
+		}
+
+		fieldPath = joinFieldPath(fieldPath, segment)
+
+		allowed, err := w.allowed(ctx, message.Descriptor(), fd)
+		if err != nil {
+			return "", err
+		}
+
+		if !allowed {
+			return fieldPath, nil
+		}
+
+		if idx == len(segments)-1 {
+			if !message.Has(fd) {
+				return "", nil
+			}
+
+			return w.checkValue(ctx, fd, message.Get(fd), fieldPath)
+		}
+
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return "", nil
+		}
+
+		message = message.Get(fd).Message()
+	}
+
+	return "", nil
+}
+
+// allowed evaluates write rules of the field for the current subject.
+// Fields without write rules are always writable.
+func (w *writeChecker) allowed(ctx context.Context, md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) (bool, error) {
+	message, exists := w.messages[string(md.FullName())]
+	if !exists {
+		return true, nil
+	}
+
+	field, guarded := message.Fields[string(fd.Name())]
+	if !guarded || field.WriteRules == nil {
+		return true, nil
+	}
+
+	if allowed, cached := w.decisions[field]; cached {
+		return allowed, nil
+	}
+
+	result, err := w.interceptor.evaluateRules(ctx, field.WriteRules, w.input)
+	if err != nil {
+		return false, err
+	}
+
+	w.decisions[field] = result.Allowed
+
+	return result.Allowed, nil
+}
+
+// findFieldMask returns the first top-level google.protobuf.FieldMask field of the request.
+func findFieldMask(request protoreflect.Message) *fieldmaskpb.FieldMask {
+	fields := request.Descriptor().Fields()
+	for j := 0; j < fields.Len(); j++ {
+		fd := fields.Get(j)
+		if fd.Message() == nil || fd.Message().FullName() != fieldMaskFullName || fd.IsList() || !request.Has(fd) {
+			continue
+		}
+
+		var mask fieldmaskpb.FieldMask
+		proto.Merge(&mask, request.Get(fd).Message().Interface())
+
+		return &mask
+	}
+
+	return nil
+}
+
+// resolveMaskPath returns the mask path relative to the request.
+// Paths that do not start with a request field are resolved against
+// the first top-level message field that has such a field (AIP-134 style resources).
+func resolveMaskPath(request protoreflect.MessageDescriptor, maskPath string) string {
+	head, _, _ := strings.Cut(maskPath, ".")
+	if request.Fields().ByName(protoreflect.Name(head)) != nil {
+		return maskPath
+	}
+
+	fields := request.Fields()
+	for j := 0; j < fields.Len(); j++ {
+		fd := fields.Get(j)
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() || fd.Message().FullName() == fieldMaskFullName {
+			continue
+		}
+
+		if fd.Message().Fields().ByName(protoreflect.Name(head)) != nil {
+			return joinFieldPath(string(fd.Name()), maskPath)
+		}
+	}
+
+	return maskPath
+}
+
+func joinFieldPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
@@ -0,0 +1,246 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/casnerano/protoc-gen-go-guard/pkg/guard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// testUpdateRequestDescriptor describes:
+//
+//	message User { string name = 1; string role = 2; repeated User delegates = 3; }
+//	message UpdateUserRequest { User user = 1; google.protobuf.FieldMask update_mask = 2; }
+func testUpdateRequestDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+
+	field := func(name string, number int32, fieldType descriptorpb.FieldDescriptorProto_Type, typeName string, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     fieldType.Enum(),
+			Label:    label.Enum(),
+		}
+		if typeName != "" {
+			fd.TypeName = proto.String(typeName)
+		}
+		return fd
+	}
+
+	const (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		str      = descriptorpb.FieldDescriptorProto_TYPE_STRING
+		message  = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	)
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("write_protection_test.proto"),
+		Package:    proto.String("test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/field_mask.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("User"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("name", 1, str, "", optional),
+					field("role", 2, str, "", optional),
+					field("delegates", 3, message, ".test.User", repeated),
+				},
+			},
+			{
+				Name: proto.String("UpdateUserRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("user", 1, message, ".test.User", optional),
+					field("update_mask", 2, message, ".google.protobuf.FieldMask", optional),
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	return fd.Messages().ByName("UpdateUserRequest")
+}
+
+func Test_interceptor_checkWriteRules(t *testing.T) {
+	requestDesc := testUpdateRequestDescriptor(t)
+	userDesc := requestDesc.Fields().ByName("user").Message()
+	maskDesc := requestDesc.Fields().ByName("update_mask").Message()
+
+	newRequest := func(user map[string]string, delegateRole string, maskPaths ...string) proto.Message {
+		request := dynamicpb.NewMessage(requestDesc)
+
+		if user != nil {
+			userMessage := dynamicpb.NewMessage(userDesc)
+			for name, value := range user {
+				userMessage.Set(userDesc.Fields().ByName(protoreflect.Name(name)), protoreflect.ValueOfString(value))
+			}
+
+			if delegateRole != "" {
+				delegate := dynamicpb.NewMessage(userDesc)
+				delegate.Set(userDesc.Fields().ByName("role"), protoreflect.ValueOfString(delegateRole))
+
+				delegates := userMessage.Mutable(userDesc.Fields().ByName("delegates")).List()
+				delegates.Append(protoreflect.ValueOfMessage(delegate))
+			}
+
+			request.Set(requestDesc.Fields().ByName("user"), protoreflect.ValueOfMessage(userMessage))
+		}
+
+		if len(maskPaths) > 0 {
+			mask := dynamicpb.NewMessage(maskDesc)
+			paths := mask.Mutable(maskDesc.Fields().ByName("paths")).List()
+			for _, maskPath := range maskPaths {
+				paths.Append(protoreflect.ValueOfString(maskPath))
+			}
+
+			request.Set(requestDesc.Fields().ByName("update_mask"), protoreflect.ValueOfMessage(mask))
+		}
+
+		return request
+	}
+
+	messages := map[string]*guard.Message{
+		"test.UpdateUserRequest": {},
+		"test.User": {
+			Fields: map[string]*guard.Field{
+				"role": {
+					WriteRules: guard.Rules{
+						{
+							AuthenticatedAccess: &guard.AuthenticatedAccess{
+								RoleBased: &guard.RoleBased{
+									Roles:       []string{"admin"},
+									Requirement: guard.RequirementAtLeastOne,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		messages     map[string]*guard.Message
+		subject      *Subject
+		request      proto.Message
+		expectedCode codes.Code
+		expectedPath string
+	}{
+		{
+			name:         "service without messages",
+			messages:     nil,
+			subject:      &Subject{},
+			request:      newRequest(map[string]string{"role": "admin"}, ""),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "unprotected field set by user",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"user"}},
+			request:      newRequest(map[string]string{"name": "name"}, ""),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "protected field set by user",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"user"}},
+			request:      newRequest(map[string]string{"name": "name", "role": "admin"}, ""),
+			expectedCode: codes.PermissionDenied,
+			expectedPath: "user.role",
+		},
+		{
+			name:         "protected field set by admin",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"admin"}},
+			request:      newRequest(map[string]string{"role": "admin"}, ""),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "protected field in repeated message set by user",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"user"}},
+			request:      newRequest(map[string]string{"name": "name"}, "admin"),
+			expectedCode: codes.PermissionDenied,
+			expectedPath: "user.delegates[0].role",
+		},
+		{
+			name:         "protected field populated but not in field mask",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"user"}},
+			request:      newRequest(map[string]string{"name": "name", "role": "admin"}, "", "name"),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "protected field cleared through resource-relative field mask",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"user"}},
+			request:      newRequest(map[string]string{"name": "name"}, "", "name", "role"),
+			expectedCode: codes.PermissionDenied,
+			expectedPath: "user.role",
+		},
+		{
+			name:         "protected field in request-relative field mask",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"user"}},
+			request:      newRequest(map[string]string{"role": "admin"}, "", "user.role"),
+			expectedCode: codes.PermissionDenied,
+			expectedPath: "user.role",
+		},
+		{
+			name:         "protected nested field under masked parent",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"user"}},
+			request:      newRequest(map[string]string{"role": "admin"}, "", "user"),
+			expectedCode: codes.PermissionDenied,
+			expectedPath: "user.role",
+		},
+		{
+			name:         "wildcard field mask",
+			messages:     messages,
+			subject:      &Subject{Roles: []string{"user"}},
+			request:      newRequest(map[string]string{"role": "admin"}, "", "*"),
+			expectedCode: codes.PermissionDenied,
+			expectedPath: "user.role",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deniedResult *EvaluationResult
+
+			i := &Interceptor{
+				eventHandlers: EventHandlers{
+					OnAccessDenied: func(_ context.Context, _ *Input, result *EvaluationResult) {
+						deniedResult = result
+					},
+				},
+			}
+			server := mockGuardServiceProvider{service: &guard.Service{Messages: tt.messages}}
+			input := &Input{Request: tt.request, Subject: tt.subject}
+
+			err := i.checkWriteRules(context.Background(), server, "/test.Service/UpdateUser", tt.request, input)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			if tt.expectedPath != "" {
+				require.NotNil(t, deniedResult)
+				assert.Equal(t, RuleKindWriteProtected, deniedResult.Rule)
+				assert.Equal(t, []string{tt.expectedPath}, deniedResult.Details)
+				assert.Contains(t, status.Convert(err).Message(), tt.expectedPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Package spiffe matches SPIFFE IDs of workload certificates against patterns
+// of guard workload rules.
+//
+// The same patterns are validated by the plugin at generation time
+// and matched by the interceptor at runtime. A pattern is a SPIFFE ID
+// whose trust domain and path segments may contain path.Match wildcards,
+// e.g. "spiffe://*.example.com/ns/billing/sa/*", and whose last segment
+// may be "**" to match any remaining path, including none.
+package spiffe
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	scheme       = "spiffe"
+	schemePrefix = scheme + "://"
+	anyPath      = "**"
+)
+
+var ErrInvalidPattern = errors.New("invalid SPIFFE ID pattern")
+
+// ValidatePattern checks that the pattern is a well-formed SPIFFE ID pattern.
+func ValidatePattern(pattern string) error {
+	segments, ok := split(pattern)
+	if !ok {
+		return fmt.Errorf("%w %q: must start with %q", ErrInvalidPattern, pattern, schemePrefix)
+	}
+
+	for idx, segment := range segments {
+		switch {
+		case segment == "" && idx == 0:
+			return fmt.Errorf("%w %q: empty trust domain", ErrInvalidPattern, pattern)
+		case segment == "":
+			return fmt.Errorf("%w %q: empty path segment", ErrInvalidPattern, pattern)
+		case segment == anyPath && (idx == 0 || idx != len(segments)-1):
+			return fmt.Errorf("%w %q: %q is only allowed as the last path segment", ErrInvalidPattern, pattern, anyPath)
+		}
+
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("%w %q: %w", ErrInvalidPattern, pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// Match reports whether the SPIFFE ID matches the pattern.
+// Invalid patterns and IDs match nothing.
+func Match(pattern, id string) bool {
+	patternSegments, ok := split(pattern)
+	if !ok {
+		return false
+	}
+
+	idSegments, ok := split(id)
+	if !ok {
+		return false
+	}
+
+	for idx, patternSegment := range patternSegments {
+		if patternSegment == anyPath && idx > 0 && idx == len(patternSegments)-1 {
+			return true
+		}
+
+		if idx >= len(idSegments) {
+			return false
+		}
+
+		if matched, err := path.Match(patternSegment, idSegments[idx]); err != nil || !matched {
+			return false
+		}
+	}
+
+	return len(idSegments) == len(patternSegments)
+}
+
+// ID returns the SPIFFE ID of the certificate. Following the X.509-SVID specification,
+// the certificate must have exactly one URI subject alternative name with the spiffe scheme.
+func ID(cert *x509.Certificate) (string, bool) {
+	if cert == nil {
+		return "", false
+	}
+
+	var id string
+	for _, uri := range cert.URIs {
+		if uri.Scheme != scheme {
+			continue
+		}
+
+		if id != "" {
+			return "", false
+		}
+
+		id = uri.String()
+	}
+
+	return id, id != ""
+}
+
+// split returns the trust domain followed by the path segments of a SPIFFE ID or pattern.
+func split(id string) ([]string, bool) {
+	rest, found := strings.CutPrefix(id, schemePrefix)
+	if !found {
+		return nil, false
+	}
+
+	return strings.Split(rest, "/"), true
+}
@@ -0,0 +1,222 @@
+package spiffe
+
+import (
+	"crypto/x509"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidatePattern(t *testing.T) {
+	tests := []struct {
+		name         string
+		pattern      string
+		errAssertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "exact id",
+			pattern:      "spiffe://prod.example.com/ns/billing/sa/worker",
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "wildcards",
+			pattern:      "spiffe://*.example.com/ns/*/sa/worker-*",
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "any remaining path",
+			pattern:      "spiffe://prod.example.com/ns/billing/**",
+			errAssertion: assert.NoError,
+		},
+		{
+			name:         "trust domain only",
+			pattern:      "spiffe://prod.example.com",
+			errAssertion: assert.NoError,
+		},
+		{
+			name:    "other scheme",
+			pattern: "https://prod.example.com/ns/billing",
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `invalid SPIFFE ID pattern "https://prod.example.com/ns/billing": must start with "spiffe://"`)
+			},
+		},
+		{
+			name:         "empty trust domain",
+			pattern:      "spiffe:///ns/billing",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "empty path segment",
+			pattern:      "spiffe://prod.example.com/ns//billing",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "trailing slash",
+			pattern:      "spiffe://prod.example.com/ns/",
+			errAssertion: assert.Error,
+		},
+		{
+			name:    "any path in the middle",
+			pattern: "spiffe://prod.example.com/**/worker",
+			errAssertion: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.EqualError(t, err, `invalid SPIFFE ID pattern "spiffe://prod.example.com/**/worker": "**" is only allowed as the last path segment`)
+			},
+		},
+		{
+			name:         "any trust domain and path",
+			pattern:      "spiffe://**",
+			errAssertion: assert.Error,
+		},
+		{
+			name:         "malformed wildcard",
+			pattern:      "spiffe://prod.example.com/ns/[billing",
+			errAssertion: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePattern(tt.pattern)
+			if tt.errAssertion(t, err) && err != nil {
+				assert.ErrorIs(t, err, ErrInvalidPattern)
+			}
+		})
+	}
+}
+
+func Test_Match(t *testing.T) {
+	const id = "spiffe://prod.example.com/ns/billing/sa/worker"
+
+	tests := []struct {
+		name    string
+		pattern string
+		id      string
+		want    bool
+	}{
+		{
+			name:    "exact id",
+			pattern: id,
+			id:      id,
+			want:    true,
+		},
+		{
+			name:    "different path",
+			pattern: "spiffe://prod.example.com/ns/billing/sa/api",
+			id:      id,
+			want:    false,
+		},
+		{
+			name:    "path segment wildcard",
+			pattern: "spiffe://prod.example.com/ns/*/sa/worker",
+			id:      id,
+			want:    true,
+		},
+		{
+			name:    "path segment wildcard does not cross segments",
+			pattern: "spiffe://prod.example.com/ns/*/worker",
+			id:      id,
+			want:    false,
+		},
+		{
+			name:    "partial path segment wildcard",
+			pattern: "spiffe://prod.example.com/ns/billing/sa/work*",
+			id:      id,
+			want:    true,
+		},
+		{
+			name:    "trust domain wildcard",
+			pattern: "spiffe://*.example.com/ns/billing/sa/worker",
+			id:      id,
+			want:    true,
+		},
+		{
+			name:    "other trust domain",
+			pattern: "spiffe://staging.example.com/ns/billing/sa/worker",
+			id:      id,
+			want:    false,
+		},
+		{
+			name:    "any remaining path",
+			pattern: "spiffe://prod.example.com/ns/billing/**",
+			id:      id,
+			want:    true,
+		},
+		{
+			name:    "any remaining path matches none",
+			pattern: "spiffe://prod.example.com/ns/billing/**",
+			id:      "spiffe://prod.example.com/ns/billing",
+			want:    true,
+		},
+		{
+			name:    "any remaining path of other namespace",
+			pattern: "spiffe://prod.example.com/ns/web/**",
+			id:      id,
+			want:    false,
+		},
+		{
+			name:    "shorter id",
+			pattern: id,
+			id:      "spiffe://prod.example.com/ns/billing",
+			want:    false,
+		},
+		{
+			name:    "longer id",
+			pattern: "spiffe://prod.example.com/ns/billing",
+			id:      id,
+			want:    false,
+		},
+		{
+			name:    "id of other scheme",
+			pattern: id,
+			id:      "https://prod.example.com/ns/billing/sa/worker",
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Match(tt.pattern, tt.id))
+		})
+	}
+}
+
+func Test_ID(t *testing.T) {
+	var (
+		spiffeURI = &url.URL{Scheme: "spiffe", Host: "prod.example.com", Path: "/ns/billing/sa/worker"}
+		httpsURI  = &url.URL{Scheme: "https", Host: "billing.example.com"}
+	)
+
+	tests := []struct {
+		name   string
+		cert   *x509.Certificate
+		want   string
+		wantOk bool
+	}{
+		{
+			name: "without certificate",
+		},
+		{
+			name: "without uris",
+			cert: &x509.Certificate{},
+		},
+		{
+			name:   "single spiffe uri",
+			cert:   &x509.Certificate{URIs: []*url.URL{httpsURI, spiffeURI}},
+			want:   "spiffe://prod.example.com/ns/billing/sa/worker",
+			wantOk: true,
+		},
+		{
+			name: "several spiffe uris",
+			cert: &x509.Certificate{URIs: []*url.URL{spiffeURI, spiffeURI}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ID(tt.cert)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
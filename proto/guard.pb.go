@@ -26,6 +26,10 @@ type Requirement int32
 const (
 	Requirement_AT_LEAST_ONE Requirement = 0
 	Requirement_ALL          Requirement = 1
+	// None of the listed items may match.
+	Requirement_NONE Requirement = 2
+	// At least `threshold` of the listed items must match.
+	Requirement_AT_LEAST Requirement = 3
 )
 
 // Enum value maps for Requirement.
@@ -33,10 +37,14 @@ var (
 	Requirement_name = map[int32]string{
 		0: "AT_LEAST_ONE",
 		1: "ALL",
+		2: "NONE",
+		3: "AT_LEAST",
 	}
 	Requirement_value = map[string]int32{
 		"AT_LEAST_ONE": 0,
 		"ALL":          1,
+		"NONE":         2,
+		"AT_LEAST":     3,
 	}
 )
 
@@ -67,6 +75,265 @@ func (Requirement) EnumDescriptor() ([]byte, []int) {
 	return file_proto_guard_proto_rawDescGZIP(), []int{0}
 }
 
+// Inherit defines how method rules relate to the rules the method would otherwise inherit
+// (service rules, file rules or interceptor default rules).
+type Inherit int32
+
+const (
+	// Method rules replace inherited rules.
+	Inherit_REPLACE Inherit = 0
+	// Access is granted by either inherited rules or method rules.
+	Inherit_APPEND Inherit = 1
+	// Access is granted only when both inherited rules and method rules allow it.
+	Inherit_INTERSECT Inherit = 2
+)
+
+// Enum value maps for Inherit.
+var (
+	Inherit_name = map[int32]string{
+		0: "REPLACE",
+		1: "APPEND",
+		2: "INTERSECT",
+	}
+	Inherit_value = map[string]int32{
+		"REPLACE":   0,
+		"APPEND":    1,
+		"INTERSECT": 2,
+	}
+)
+
+func (x Inherit) Enum() *Inherit {
+	p := new(Inherit)
+	*p = x
+	return p
+}
+
+func (x Inherit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Inherit) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_guard_proto_enumTypes[1].Descriptor()
+}
+
+func (Inherit) Type() protoreflect.EnumType {
+	return &file_proto_guard_proto_enumTypes[1]
+}
+
+func (x Inherit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Inherit.Descriptor instead.
+func (Inherit) EnumDescriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{1}
+}
+
+type Weekday int32
+
+const (
+	Weekday_WEEKDAY_UNSPECIFIED Weekday = 0
+	Weekday_MONDAY              Weekday = 1
+	Weekday_TUESDAY             Weekday = 2
+	Weekday_WEDNESDAY           Weekday = 3
+	Weekday_THURSDAY            Weekday = 4
+	Weekday_FRIDAY              Weekday = 5
+	Weekday_SATURDAY            Weekday = 6
+	Weekday_SUNDAY              Weekday = 7
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "WEEKDAY_UNSPECIFIED",
+		1: "MONDAY",
+		2: "TUESDAY",
+		3: "WEDNESDAY",
+		4: "THURSDAY",
+		5: "FRIDAY",
+		6: "SATURDAY",
+		7: "SUNDAY",
+	}
+	Weekday_value = map[string]int32{
+		"WEEKDAY_UNSPECIFIED": 0,
+		"MONDAY":              1,
+		"TUESDAY":             2,
+		"WEDNESDAY":           3,
+		"THURSDAY":            4,
+		"FRIDAY":              5,
+		"SATURDAY":            6,
+		"SUNDAY":              7,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_guard_proto_enumTypes[2].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_proto_guard_proto_enumTypes[2]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{2}
+}
+
+// gRPC status codes, except OK.
+type Denial_Code int32
+
+const (
+	Denial_UNSPECIFIED         Denial_Code = 0
+	Denial_CANCELLED           Denial_Code = 1
+	Denial_UNKNOWN             Denial_Code = 2
+	Denial_INVALID_ARGUMENT    Denial_Code = 3
+	Denial_DEADLINE_EXCEEDED   Denial_Code = 4
+	Denial_NOT_FOUND           Denial_Code = 5
+	Denial_ALREADY_EXISTS      Denial_Code = 6
+	Denial_PERMISSION_DENIED   Denial_Code = 7
+	Denial_RESOURCE_EXHAUSTED  Denial_Code = 8
+	Denial_FAILED_PRECONDITION Denial_Code = 9
+	Denial_ABORTED             Denial_Code = 10
+	Denial_OUT_OF_RANGE        Denial_Code = 11
+	Denial_UNIMPLEMENTED       Denial_Code = 12
+	Denial_INTERNAL            Denial_Code = 13
+	Denial_UNAVAILABLE         Denial_Code = 14
+	Denial_DATA_LOSS           Denial_Code = 15
+	Denial_UNAUTHENTICATED     Denial_Code = 16
+)
+
+// Enum value maps for Denial_Code.
+var (
+	Denial_Code_name = map[int32]string{
+		0:  "UNSPECIFIED",
+		1:  "CANCELLED",
+		2:  "UNKNOWN",
+		3:  "INVALID_ARGUMENT",
+		4:  "DEADLINE_EXCEEDED",
+		5:  "NOT_FOUND",
+		6:  "ALREADY_EXISTS",
+		7:  "PERMISSION_DENIED",
+		8:  "RESOURCE_EXHAUSTED",
+		9:  "FAILED_PRECONDITION",
+		10: "ABORTED",
+		11: "OUT_OF_RANGE",
+		12: "UNIMPLEMENTED",
+		13: "INTERNAL",
+		14: "UNAVAILABLE",
+		15: "DATA_LOSS",
+		16: "UNAUTHENTICATED",
+	}
+	Denial_Code_value = map[string]int32{
+		"UNSPECIFIED":         0,
+		"CANCELLED":           1,
+		"UNKNOWN":             2,
+		"INVALID_ARGUMENT":    3,
+		"DEADLINE_EXCEEDED":   4,
+		"NOT_FOUND":           5,
+		"ALREADY_EXISTS":      6,
+		"PERMISSION_DENIED":   7,
+		"RESOURCE_EXHAUSTED":  8,
+		"FAILED_PRECONDITION": 9,
+		"ABORTED":             10,
+		"OUT_OF_RANGE":        11,
+		"UNIMPLEMENTED":       12,
+		"INTERNAL":            13,
+		"UNAVAILABLE":         14,
+		"DATA_LOSS":           15,
+		"UNAUTHENTICATED":     16,
+	}
+)
+
+func (x Denial_Code) Enum() *Denial_Code {
+	p := new(Denial_Code)
+	*p = x
+	return p
+}
+
+func (x Denial_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Denial_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_guard_proto_enumTypes[3].Descriptor()
+}
+
+func (Denial_Code) Type() protoreflect.EnumType {
+	return &file_proto_guard_proto_enumTypes[3]
+}
+
+func (x Denial_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Denial_Code.Descriptor instead.
+func (Denial_Code) EnumDescriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{9, 0}
+}
+
+// Combine defines how the configured checks are combined.
+type AuthenticatedAccess_Combine int32
+
+const (
+	// Every configured check must pass.
+	AuthenticatedAccess_ALL AuthenticatedAccess_Combine = 0
+	// At least one configured check must pass.
+	AuthenticatedAccess_ANY AuthenticatedAccess_Combine = 1
+)
+
+// Enum value maps for AuthenticatedAccess_Combine.
+var (
+	AuthenticatedAccess_Combine_name = map[int32]string{
+		0: "ALL",
+		1: "ANY",
+	}
+	AuthenticatedAccess_Combine_value = map[string]int32{
+		"ALL": 0,
+		"ANY": 1,
+	}
+)
+
+func (x AuthenticatedAccess_Combine) Enum() *AuthenticatedAccess_Combine {
+	p := new(AuthenticatedAccess_Combine)
+	*p = x
+	return p
+}
+
+func (x AuthenticatedAccess_Combine) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuthenticatedAccess_Combine) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_guard_proto_enumTypes[4].Descriptor()
+}
+
+func (AuthenticatedAccess_Combine) Type() protoreflect.EnumType {
+	return &file_proto_guard_proto_enumTypes[4]
+}
+
+func (x AuthenticatedAccess_Combine) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuthenticatedAccess_Combine.Descriptor instead.
+func (AuthenticatedAccess_Combine) EnumDescriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{13, 0}
+}
+
 type RoleBased struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -74,6 +341,8 @@ type RoleBased struct {
 
 	Roles       []string     `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	Requirement *Requirement `protobuf:"varint,2,opt,name=requirement,proto3,enum=guard.Requirement,oneof" json:"requirement,omitempty"`
+	// Minimum number of matching roles for the AT_LEAST requirement.
+	Threshold *uint32 `protobuf:"varint,3,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
 }
 
 func (x *RoleBased) Reset() {
@@ -122,17 +391,27 @@ func (x *RoleBased) GetRequirement() Requirement {
 	return Requirement_AT_LEAST_ONE
 }
 
-type PolicyBased struct {
+func (x *RoleBased) GetThreshold() uint32 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return 0
+}
+
+// ScopeBased checks OAuth2 scopes granted to the client, as opposed to roles of the user.
+type ScopeBased struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Policies    []string     `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	Scopes      []string     `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	Requirement *Requirement `protobuf:"varint,2,opt,name=requirement,proto3,enum=guard.Requirement,oneof" json:"requirement,omitempty"`
+	// Minimum number of granted scopes for the AT_LEAST requirement.
+	Threshold *uint32 `protobuf:"varint,3,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
 }
 
-func (x *PolicyBased) Reset() {
-	*x = PolicyBased{}
+func (x *ScopeBased) Reset() {
+	*x = ScopeBased{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_guard_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -140,13 +419,13 @@ func (x *PolicyBased) Reset() {
 	}
 }
 
-func (x *PolicyBased) String() string {
+func (x *ScopeBased) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyBased) ProtoMessage() {}
+func (*ScopeBased) ProtoMessage() {}
 
-func (x *PolicyBased) ProtoReflect() protoreflect.Message {
+func (x *ScopeBased) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_guard_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -158,40 +437,48 @@ func (x *PolicyBased) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyBased.ProtoReflect.Descriptor instead.
-func (*PolicyBased) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScopeBased.ProtoReflect.Descriptor instead.
+func (*ScopeBased) Descriptor() ([]byte, []int) {
 	return file_proto_guard_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *PolicyBased) GetPolicies() []string {
+func (x *ScopeBased) GetScopes() []string {
 	if x != nil {
-		return x.Policies
+		return x.Scopes
 	}
 	return nil
 }
 
-func (x *PolicyBased) GetRequirement() Requirement {
+func (x *ScopeBased) GetRequirement() Requirement {
 	if x != nil && x.Requirement != nil {
 		return *x.Requirement
 	}
 	return Requirement_AT_LEAST_ONE
 }
 
-type Rule struct {
+func (x *ScopeBased) GetThreshold() uint32 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return 0
+}
+
+type PolicyBased struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Mode:
-	//
-	//	*Rule_AllowPublic
-	//	*Rule_RequireAuthentication
-	//	*Rule_AuthenticatedAccess
-	Mode isRule_Mode `protobuf_oneof:"mode"`
+	Policies    []string     `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	Requirement *Requirement `protobuf:"varint,2,opt,name=requirement,proto3,enum=guard.Requirement,oneof" json:"requirement,omitempty"`
+	// Policies called with arguments, evaluated together with `policies`.
+	// Each policy may be listed or referenced once.
+	References []*PolicyReference `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
+	// Minimum number of passed policies for the AT_LEAST requirement.
+	Threshold *uint32 `protobuf:"varint,4,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
 }
 
-func (x *Rule) Reset() {
-	*x = Rule{}
+func (x *PolicyBased) Reset() {
+	*x = PolicyBased{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_guard_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -199,13 +486,13 @@ func (x *Rule) Reset() {
 	}
 }
 
-func (x *Rule) String() string {
+func (x *PolicyBased) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule) ProtoMessage() {}
+func (*PolicyBased) ProtoMessage() {}
 
-func (x *Rule) ProtoReflect() protoreflect.Message {
+func (x *PolicyBased) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_guard_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -217,87 +504,125 @@ func (x *Rule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
-func (*Rule) Descriptor() ([]byte, []int) {
+// Deprecated: Use PolicyBased.ProtoReflect.Descriptor instead.
+func (*PolicyBased) Descriptor() ([]byte, []int) {
 	return file_proto_guard_proto_rawDescGZIP(), []int{2}
 }
 
-func (m *Rule) GetMode() isRule_Mode {
-	if m != nil {
-		return m.Mode
+func (x *PolicyBased) GetPolicies() []string {
+	if x != nil {
+		return x.Policies
 	}
 	return nil
 }
 
-func (x *Rule) GetAllowPublic() bool {
-	if x, ok := x.GetMode().(*Rule_AllowPublic); ok {
-		return x.AllowPublic
+func (x *PolicyBased) GetRequirement() Requirement {
+	if x != nil && x.Requirement != nil {
+		return *x.Requirement
 	}
-	return false
+	return Requirement_AT_LEAST_ONE
 }
 
-func (x *Rule) GetRequireAuthentication() bool {
-	if x, ok := x.GetMode().(*Rule_RequireAuthentication); ok {
-		return x.RequireAuthentication
+func (x *PolicyBased) GetReferences() []*PolicyReference {
+	if x != nil {
+		return x.References
 	}
-	return false
+	return nil
 }
 
-func (x *Rule) GetAuthenticatedAccess() *AuthenticatedAccess {
-	if x, ok := x.GetMode().(*Rule_AuthenticatedAccess); ok {
-		return x.AuthenticatedAccess
+func (x *PolicyBased) GetThreshold() uint32 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
 	}
-	return nil
+	return 0
 }
 
-type isRule_Mode interface {
-	isRule_Mode()
+// PolicyReference names a policy and the arguments passed to it.
+type PolicyReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args map[string]*PolicyArgument `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-type Rule_AllowPublic struct {
-	AllowPublic bool `protobuf:"varint,1,opt,name=allow_public,json=allowPublic,proto3,oneof"`
+func (x *PolicyReference) Reset() {
+	*x = PolicyReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Rule_RequireAuthentication struct {
-	RequireAuthentication bool `protobuf:"varint,2,opt,name=require_authentication,json=requireAuthentication,proto3,oneof"`
+func (x *PolicyReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Rule_AuthenticatedAccess struct {
-	AuthenticatedAccess *AuthenticatedAccess `protobuf:"bytes,3,opt,name=authenticated_access,json=authenticatedAccess,proto3,oneof"`
+func (*PolicyReference) ProtoMessage() {}
+
+func (x *PolicyReference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*Rule_AllowPublic) isRule_Mode() {}
+// Deprecated: Use PolicyReference.ProtoReflect.Descriptor instead.
+func (*PolicyReference) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{3}
+}
 
-func (*Rule_RequireAuthentication) isRule_Mode() {}
+func (x *PolicyReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-func (*Rule_AuthenticatedAccess) isRule_Mode() {}
+func (x *PolicyReference) GetArgs() map[string]*PolicyArgument {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
 
-type AuthenticatedAccess struct {
+type PolicyArgument struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoleBased   *RoleBased   `protobuf:"bytes,1,opt,name=role_based,json=roleBased,proto3" json:"role_based,omitempty"`
-	PolicyBased *PolicyBased `protobuf:"bytes,2,opt,name=policy_based,json=policyBased,proto3" json:"policy_based,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*PolicyArgument_StringValue
+	//	*PolicyArgument_NumberValue
+	//	*PolicyArgument_BoolValue
+	Value isPolicyArgument_Value `protobuf_oneof:"value"`
 }
 
-func (x *AuthenticatedAccess) Reset() {
-	*x = AuthenticatedAccess{}
+func (x *PolicyArgument) Reset() {
+	*x = PolicyArgument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_guard_proto_msgTypes[3]
+		mi := &file_proto_guard_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthenticatedAccess) String() string {
+func (x *PolicyArgument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthenticatedAccess) ProtoMessage() {}
+func (*PolicyArgument) ProtoMessage() {}
 
-func (x *AuthenticatedAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_guard_proto_msgTypes[3]
+func (x *PolicyArgument) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,54 +633,1084 @@ func (x *AuthenticatedAccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthenticatedAccess.ProtoReflect.Descriptor instead.
-func (*AuthenticatedAccess) Descriptor() ([]byte, []int) {
-	return file_proto_guard_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PolicyArgument.ProtoReflect.Descriptor instead.
+func (*PolicyArgument) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AuthenticatedAccess) GetRoleBased() *RoleBased {
-	if x != nil {
-		return x.RoleBased
+func (m *PolicyArgument) GetValue() isPolicyArgument_Value {
+	if m != nil {
+		return m.Value
 	}
 	return nil
 }
 
-func (x *AuthenticatedAccess) GetPolicyBased() *PolicyBased {
-	if x != nil {
-		return x.PolicyBased
+func (x *PolicyArgument) GetStringValue() string {
+	if x, ok := x.GetValue().(*PolicyArgument_StringValue); ok {
+		return x.StringValue
 	}
-	return nil
+	return ""
 }
 
-var file_proto_guard_proto_extTypes = []protoimpl.ExtensionInfo{
-	{
-		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
-		ExtensionType: ([]*Rule)(nil),
-		Field:         50001,
-		Name:          "guard.service_rules",
-		Tag:           "bytes,50001,rep,name=service_rules",
-		Filename:      "proto/guard.proto",
-	},
-	{
-		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
-		ExtensionType: ([]*Rule)(nil),
-		Field:         50002,
-		Name:          "guard.method_rules",
-		Tag:           "bytes,50002,rep,name=method_rules",
-		Filename:      "proto/guard.proto",
-	},
+func (x *PolicyArgument) GetNumberValue() float64 {
+	if x, ok := x.GetValue().(*PolicyArgument_NumberValue); ok {
+		return x.NumberValue
+	}
+	return 0
 }
 
-// Extension fields to descriptorpb.ServiceOptions.
-var (
-	// repeated guard.Rule service_rules = 50001;
-	E_ServiceRules = &file_proto_guard_proto_extTypes[0]
-)
+func (x *PolicyArgument) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*PolicyArgument_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
 
-// Extension fields to descriptorpb.MethodOptions.
-var (
-	// repeated guard.Rule method_rules = 50002;
-	E_MethodRules = &file_proto_guard_proto_extTypes[1]
+type isPolicyArgument_Value interface {
+	isPolicyArgument_Value()
+}
+
+type PolicyArgument_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type PolicyArgument_NumberValue struct {
+	NumberValue float64 `protobuf:"fixed64,2,opt,name=number_value,json=numberValue,proto3,oneof"`
+}
+
+type PolicyArgument_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*PolicyArgument_StringValue) isPolicyArgument_Value() {}
+
+func (*PolicyArgument_NumberValue) isPolicyArgument_Value() {}
+
+func (*PolicyArgument_BoolValue) isPolicyArgument_Value() {}
+
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Mode:
+	//
+	//	*Rule_AllowPublic
+	//	*Rule_RequireAuthentication
+	//	*Rule_AuthenticatedAccess
+	//	*Rule_Condition
+	//	*Rule_Use
+	//	*Rule_Workload
+	Mode isRule_Mode `protobuf_oneof:"mode"`
+	// Outside of its time constraints the rule never allows access.
+	// RFC 3339 time the rule applies from, inclusive.
+	ValidFrom string `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// RFC 3339 time the rule applies until, exclusive.
+	ValidUntil string `protobuf:"bytes,7,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// Recurring windows the rule applies in; one matching window is enough.
+	Windows []*TimeWindow `protobuf:"bytes,8,rep,name=windows,proto3" json:"windows,omitempty"`
+	// Status returned when access is denied by this rule.
+	Denial *Denial `protobuf:"bytes,9,opt,name=denial,proto3" json:"denial,omitempty"`
+	// Client networks the rule applies to; from other networks the rule never allows access.
+	Network *Network `protobuf:"bytes,10,opt,name=network,proto3" json:"network,omitempty"`
+	// Incoming metadata the request must match, all of the entries;
+	// otherwise the rule never allows access.
+	Metadata []*MetadataMatch `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *Rule) GetMode() isRule_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return nil
+}
+
+func (x *Rule) GetAllowPublic() bool {
+	if x, ok := x.GetMode().(*Rule_AllowPublic); ok {
+		return x.AllowPublic
+	}
+	return false
+}
+
+func (x *Rule) GetRequireAuthentication() bool {
+	if x, ok := x.GetMode().(*Rule_RequireAuthentication); ok {
+		return x.RequireAuthentication
+	}
+	return false
+}
+
+func (x *Rule) GetAuthenticatedAccess() *AuthenticatedAccess {
+	if x, ok := x.GetMode().(*Rule_AuthenticatedAccess); ok {
+		return x.AuthenticatedAccess
+	}
+	return nil
+}
+
+func (x *Rule) GetCondition() string {
+	if x, ok := x.GetMode().(*Rule_Condition); ok {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Rule) GetUse() string {
+	if x, ok := x.GetMode().(*Rule_Use); ok {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *Rule) GetWorkload() *Workload {
+	if x, ok := x.GetMode().(*Rule_Workload); ok {
+		return x.Workload
+	}
+	return nil
+}
+
+func (x *Rule) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *Rule) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *Rule) GetWindows() []*TimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *Rule) GetDenial() *Denial {
+	if x != nil {
+		return x.Denial
+	}
+	return nil
+}
+
+func (x *Rule) GetNetwork() *Network {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *Rule) GetMetadata() []*MetadataMatch {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isRule_Mode interface {
+	isRule_Mode()
+}
+
+type Rule_AllowPublic struct {
+	AllowPublic bool `protobuf:"varint,1,opt,name=allow_public,json=allowPublic,proto3,oneof"`
+}
+
+type Rule_RequireAuthentication struct {
+	RequireAuthentication bool `protobuf:"varint,2,opt,name=require_authentication,json=requireAuthentication,proto3,oneof"`
+}
+
+type Rule_AuthenticatedAccess struct {
+	AuthenticatedAccess *AuthenticatedAccess `protobuf:"bytes,3,opt,name=authenticated_access,json=authenticatedAccess,proto3,oneof"`
+}
+
+type Rule_Condition struct {
+	// CEL expression over `subject`, `request` and `metadata`,
+	// e.g. `subject.attrs.tenant_id == request.tenant_id`.
+	// Reading an absent attribute, metadata key or field makes the rule not match.
+	Condition string `protobuf:"bytes,4,opt,name=condition,proto3,oneof"`
+}
+
+type Rule_Use struct {
+	// Name of a rule set whose rules are inlined in place of this rule.
+	Use string `protobuf:"bytes,5,opt,name=use,proto3,oneof"`
+}
+
+type Rule_Workload struct {
+	// Calling service identified by its verified mTLS client certificate.
+	Workload *Workload `protobuf:"bytes,11,opt,name=workload,proto3,oneof"`
+}
+
+func (*Rule_AllowPublic) isRule_Mode() {}
+
+func (*Rule_RequireAuthentication) isRule_Mode() {}
+
+func (*Rule_AuthenticatedAccess) isRule_Mode() {}
+
+func (*Rule_Condition) isRule_Mode() {}
+
+func (*Rule_Use) isRule_Mode() {}
+
+func (*Rule_Workload) isRule_Mode() {}
+
+// MetadataMatch checks a key of incoming gRPC metadata.
+type MetadataMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Metadata key, case-insensitive, e.g. "x-client-app".
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Values the key must have one of; if empty, the key must be present with any value.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// Whether the key must be absent instead of present.
+	Absent bool `protobuf:"varint,3,opt,name=absent,proto3" json:"absent,omitempty"`
+}
+
+func (x *MetadataMatch) Reset() {
+	*x = MetadataMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataMatch) ProtoMessage() {}
+
+func (x *MetadataMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataMatch.ProtoReflect.Descriptor instead.
+func (*MetadataMatch) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MetadataMatch) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataMatch) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *MetadataMatch) GetAbsent() bool {
+	if x != nil {
+		return x.Absent
+	}
+	return false
+}
+
+// Workload matches the verified client certificate of the calling service.
+// The certificate matches if any of the listed identities matches.
+type Workload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SPIFFE ID patterns, e.g. "spiffe://prod.example.com/ns/billing/sa/*".
+	// "*" matches within the trust domain or a path segment, "**" as the last segment
+	// matches any remaining path.
+	SpiffeIds []string `protobuf:"bytes,1,rep,name=spiffe_ids,json=spiffeIds,proto3" json:"spiffe_ids,omitempty"`
+	// Subject common names.
+	CommonNames []string `protobuf:"bytes,2,rep,name=common_names,json=commonNames,proto3" json:"common_names,omitempty"`
+	// DNS subject alternative names; "*.example.com" matches a single label.
+	DnsNames []string `protobuf:"bytes,3,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+}
+
+func (x *Workload) Reset() {
+	*x = Workload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Workload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workload) ProtoMessage() {}
+
+func (x *Workload) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workload.ProtoReflect.Descriptor instead.
+func (*Workload) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Workload) GetSpiffeIds() []string {
+	if x != nil {
+		return x.SpiffeIds
+	}
+	return nil
+}
+
+func (x *Workload) GetCommonNames() []string {
+	if x != nil {
+		return x.CommonNames
+	}
+	return nil
+}
+
+func (x *Workload) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+// Network restricts a rule to client addresses.
+// Entries are CIDR ranges, e.g. "10.8.0.0/16", or single addresses.
+type Network struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ranges the client address must belong to; any address if empty.
+	Allow []string `protobuf:"bytes,1,rep,name=allow,proto3" json:"allow,omitempty"`
+	// Ranges the client address must not belong to, even if allowed.
+	Deny []string `protobuf:"bytes,2,rep,name=deny,proto3" json:"deny,omitempty"`
+}
+
+func (x *Network) Reset() {
+	*x = Network{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Network) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Network) ProtoMessage() {}
+
+func (x *Network) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Network) GetAllow() []string {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
+
+func (x *Network) GetDeny() []string {
+	if x != nil {
+		return x.Deny
+	}
+	return nil
+}
+
+// Denial customizes the gRPC status returned when access is denied,
+// e.g. NOT_FOUND to hide the existence of internal methods.
+type Denial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status code; UNAUTHENTICATED for unauthenticated subjects and PERMISSION_DENIED otherwise if unspecified.
+	Code Denial_Code `protobuf:"varint,1,opt,name=code,proto3,enum=guard.Denial_Code" json:"code,omitempty"`
+	// Status message; the code name if empty.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Denial) Reset() {
+	*x = Denial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Denial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Denial) ProtoMessage() {}
+
+func (x *Denial) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Denial.ProtoReflect.Descriptor instead.
+func (*Denial) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Denial) GetCode() Denial_Code {
+	if x != nil {
+		return x.Code
+	}
+	return Denial_UNSPECIFIED
+}
+
+func (x *Denial) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TimeWindow is a recurring period of time, e.g. business hours.
+// A window ending before it starts wraps past midnight, e.g. "22:00"–"06:00".
+type TimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Days of the week the window opens on; every day if empty.
+	Weekdays []Weekday `protobuf:"varint,1,rep,packed,name=weekdays,proto3,enum=guard.Weekday" json:"weekdays,omitempty"`
+	// Time of day the window opens at, "HH:MM", inclusive; midnight if empty.
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// Time of day the window closes at, "HH:MM", exclusive; end of the day ("24:00") if empty.
+	End string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// IANA time zone of the window, e.g. "Europe/Berlin"; UTC if empty.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TimeWindow) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *TimeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// RuleSet is a named list of rules declared once and referenced from other rules by `use`.
+type RuleSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rules []*Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *RuleSet) Reset() {
+	*x = RuleSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSet) ProtoMessage() {}
+
+func (x *RuleSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSet.ProtoReflect.Descriptor instead.
+func (*RuleSet) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RuleSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RuleSet) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Ownership allows access only when the request field equals the subject attribute.
+// Not supported for streaming methods, which are authorized before any request is received.
+type Ownership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dot-separated path of a string or integer request field, e.g. "user_id" or "user.id".
+	RequestField string `protobuf:"bytes,1,opt,name=request_field,json=requestField,proto3" json:"request_field,omitempty"`
+	// Key of the subject attribute compared with the request field value.
+	// String fields match string attributes, integer fields match attributes of Go integer types.
+	SubjectAttr string `protobuf:"bytes,2,opt,name=subject_attr,json=subjectAttr,proto3" json:"subject_attr,omitempty"`
+}
+
+func (x *Ownership) Reset() {
+	*x = Ownership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ownership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ownership) ProtoMessage() {}
+
+func (x *Ownership) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ownership.ProtoReflect.Descriptor instead.
+func (*Ownership) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Ownership) GetRequestField() string {
+	if x != nil {
+		return x.RequestField
+	}
+	return ""
+}
+
+func (x *Ownership) GetSubjectAttr() string {
+	if x != nil {
+		return x.SubjectAttr
+	}
+	return ""
+}
+
+type AuthenticatedAccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleBased   *RoleBased                  `protobuf:"bytes,1,opt,name=role_based,json=roleBased,proto3" json:"role_based,omitempty"`
+	PolicyBased *PolicyBased                `protobuf:"bytes,2,opt,name=policy_based,json=policyBased,proto3" json:"policy_based,omitempty"`
+	Ownership   *Ownership                  `protobuf:"bytes,3,opt,name=ownership,proto3" json:"ownership,omitempty"`
+	ScopeBased  *ScopeBased                 `protobuf:"bytes,4,opt,name=scope_based,json=scopeBased,proto3" json:"scope_based,omitempty"`
+	Combine     AuthenticatedAccess_Combine `protobuf:"varint,5,opt,name=combine,proto3,enum=guard.AuthenticatedAccess_Combine" json:"combine,omitempty"`
+	// Minimum authentication level of the subject, e.g. 2 for MFA (ACR-like, higher is stronger).
+	// Required in addition to the configured checks regardless of combine.
+	MinAuthLevel uint32 `protobuf:"varint,6,opt,name=min_auth_level,json=minAuthLevel,proto3" json:"min_auth_level,omitempty"`
+	// Maximum time since the subject authenticated, as a Go duration of whole seconds, e.g. "5m".
+	// Required in addition to the configured checks regardless of combine.
+	MaxAuthAge string `protobuf:"bytes,7,opt,name=max_auth_age,json=maxAuthAge,proto3" json:"max_auth_age,omitempty"`
+}
+
+func (x *AuthenticatedAccess) Reset() {
+	*x = AuthenticatedAccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticatedAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticatedAccess) ProtoMessage() {}
+
+func (x *AuthenticatedAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticatedAccess.ProtoReflect.Descriptor instead.
+func (*AuthenticatedAccess) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuthenticatedAccess) GetRoleBased() *RoleBased {
+	if x != nil {
+		return x.RoleBased
+	}
+	return nil
+}
+
+func (x *AuthenticatedAccess) GetPolicyBased() *PolicyBased {
+	if x != nil {
+		return x.PolicyBased
+	}
+	return nil
+}
+
+func (x *AuthenticatedAccess) GetOwnership() *Ownership {
+	if x != nil {
+		return x.Ownership
+	}
+	return nil
+}
+
+func (x *AuthenticatedAccess) GetScopeBased() *ScopeBased {
+	if x != nil {
+		return x.ScopeBased
+	}
+	return nil
+}
+
+func (x *AuthenticatedAccess) GetCombine() AuthenticatedAccess_Combine {
+	if x != nil {
+		return x.Combine
+	}
+	return AuthenticatedAccess_ALL
+}
+
+func (x *AuthenticatedAccess) GetMinAuthLevel() uint32 {
+	if x != nil {
+		return x.MinAuthLevel
+	}
+	return 0
+}
+
+func (x *AuthenticatedAccess) GetMaxAuthAge() string {
+	if x != nil {
+		return x.MaxAuthAge
+	}
+	return ""
+}
+
+// Authentication restricts the authentication schemes accepted for a service or method.
+type Authentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Names of authenticators registered with the interceptor, tried in order, e.g. ["mtls", "jwt"].
+	// Credentials of other schemes are ignored: requests presenting only them are unauthenticated.
+	Authenticators []string `protobuf:"bytes,1,rep,name=authenticators,proto3" json:"authenticators,omitempty"`
+}
+
+func (x *Authentication) Reset() {
+	*x = Authentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Authentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authentication) ProtoMessage() {}
+
+func (x *Authentication) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authentication.ProtoReflect.Descriptor instead.
+func (*Authentication) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Authentication) GetAuthenticators() []string {
+	if x != nil {
+		return x.Authenticators
+	}
+	return nil
+}
+
+// RoleInheritance declares roles implied by a role, e.g. `admin` implies `editor`.
+type RoleInheritance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role    string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Implies []string `protobuf:"bytes,2,rep,name=implies,proto3" json:"implies,omitempty"`
+}
+
+func (x *RoleInheritance) Reset() {
+	*x = RoleInheritance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_guard_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleInheritance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleInheritance) ProtoMessage() {}
+
+func (x *RoleInheritance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guard_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleInheritance.ProtoReflect.Descriptor instead.
+func (*RoleInheritance) Descriptor() ([]byte, []int) {
+	return file_proto_guard_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RoleInheritance) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleInheritance) GetImplies() []string {
+	if x != nil {
+		return x.Implies
+	}
+	return nil
+}
+
+var file_proto_guard_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: ([]*RoleInheritance)(nil),
+		Field:         50005,
+		Name:          "guard.role_hierarchy",
+		Tag:           "bytes,50005,rep,name=role_hierarchy",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: ([]*Rule)(nil),
+		Field:         50008,
+		Name:          "guard.file_rules",
+		Tag:           "bytes,50008,rep,name=file_rules",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: ([]*RuleSet)(nil),
+		Field:         50009,
+		Name:          "guard.rule_set",
+		Tag:           "bytes,50009,rep,name=rule_set",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: ([]*Rule)(nil),
+		Field:         50001,
+		Name:          "guard.service_rules",
+		Tag:           "bytes,50001,rep,name=service_rules",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: ([]*Rule)(nil),
+		Field:         50006,
+		Name:          "guard.service_deny_rules",
+		Tag:           "bytes,50006,rep,name=service_deny_rules",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*Authentication)(nil),
+		Field:         50012,
+		Name:          "guard.service_authentication",
+		Tag:           "bytes,50012,opt,name=service_authentication",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: ([]*Rule)(nil),
+		Field:         50002,
+		Name:          "guard.method_rules",
+		Tag:           "bytes,50002,rep,name=method_rules",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: ([]*Rule)(nil),
+		Field:         50007,
+		Name:          "guard.method_deny_rules",
+		Tag:           "bytes,50007,rep,name=method_deny_rules",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Inherit)(nil),
+		Field:         50010,
+		Name:          "guard.method_rules_inherit",
+		Tag:           "varint,50010,opt,name=method_rules_inherit,enum=guard.Inherit",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Denial)(nil),
+		Field:         50011,
+		Name:          "guard.method_denial",
+		Tag:           "bytes,50011,opt,name=method_denial",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Authentication)(nil),
+		Field:         50013,
+		Name:          "guard.method_authentication",
+		Tag:           "bytes,50013,opt,name=method_authentication",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: ([]*Rule)(nil),
+		Field:         50003,
+		Name:          "guard.field_rules",
+		Tag:           "bytes,50003,rep,name=field_rules",
+		Filename:      "proto/guard.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: ([]*Rule)(nil),
+		Field:         50004,
+		Name:          "guard.field_write_rules",
+		Tag:           "bytes,50004,rep,name=field_write_rules",
+		Filename:      "proto/guard.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// Role hierarchy applied to role-based rules of all services in the file.
+	// Implied roles are expanded transitively; cycles are rejected by the generator.
+	//
+	// repeated guard.RoleInheritance role_hierarchy = 50005;
+	E_RoleHierarchy = &file_proto_guard_proto_extTypes[0]
+	// Default rules for every service in the file that has no service_rules.
+	//
+	// repeated guard.Rule file_rules = 50008;
+	E_FileRules = &file_proto_guard_proto_extTypes[1]
+	// Rule sets available to this file and to files importing it.
+	// Names must be unique across the file and its imports.
+	//
+	// repeated guard.RuleSet rule_set = 50009;
+	E_RuleSet = &file_proto_guard_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// repeated guard.Rule service_rules = 50001;
+	E_ServiceRules = &file_proto_guard_proto_extTypes[3]
+	// Rules that deny access when matched, evaluated before allow rules and always winning.
+	// Unlike allow rules, they are not overridden by method deny rules.
+	//
+	// repeated guard.Rule service_deny_rules = 50006;
+	E_ServiceDenyRules = &file_proto_guard_proto_extTypes[4]
+	// Authentication schemes accepted for every method of the service without its own.
+	//
+	// optional guard.Authentication service_authentication = 50012;
+	E_ServiceAuthentication = &file_proto_guard_proto_extTypes[5]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// repeated guard.Rule method_rules = 50002;
+	E_MethodRules = &file_proto_guard_proto_extTypes[6]
+	// Rules that deny access when matched, added to the service deny rules.
+	//
+	// repeated guard.Rule method_deny_rules = 50007;
+	E_MethodDenyRules = &file_proto_guard_proto_extTypes[7]
+	// How method_rules are combined with inherited rules; REPLACE by default.
+	//
+	// optional guard.Inherit method_rules_inherit = 50010;
+	E_MethodRulesInherit = &file_proto_guard_proto_extTypes[8]
+	// Status returned when access to the method is denied, unless the denying rule declares its own.
+	//
+	// optional guard.Denial method_denial = 50011;
+	E_MethodDenial = &file_proto_guard_proto_extTypes[9]
+	// Authentication schemes accepted for the method, replacing the service ones.
+	//
+	// optional guard.Authentication method_authentication = 50013;
+	E_MethodAuthentication = &file_proto_guard_proto_extTypes[10]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Rules that decide whether the subject may see the field in responses.
+	//
+	// repeated guard.Rule field_rules = 50003;
+	E_FieldRules = &file_proto_guard_proto_extTypes[11]
+	// Rules that decide whether the subject may set the field in requests.
+	//
+	// repeated guard.Rule field_write_rules = 50004;
+	E_FieldWriteRules = &file_proto_guard_proto_extTypes[12]
 )
 
 var File_proto_guard_proto protoreflect.FileDescriptor
@@ -364,58 +1719,276 @@ var file_proto_guard_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x67, 0x75, 0x61, 0x72, 0x64, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6c, 0x0a, 0x09,
-	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12,
-	0x39, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x0b, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x75, 0x61,
-	0x72, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0xbd, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x37,
-	0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x41, 0x75,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x01, 0x0a,
+	0x09, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xa0, 0x01, 0x0a,
+	0x0a, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22,
+	0xdd, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22,
+	0xab, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x41, 0x72,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x1a, 0x4e, 0x0a,
+	0x09, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x01,
+	0x0a, 0x0e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x90, 0x04, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x12, 0x37, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x14, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x03,
+	0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x2b, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x25, 0x0a, 0x06,
+	0x64, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x06, 0x64, 0x65, 0x6e,
+	0x69, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x30, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
+	0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x08, 0x57, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x69, 0x66,
+	0x66, 0x65, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6e, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x6e, 0x79, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x6e, 0x79, 0x22, 0x8d, 0x03, 0x0a, 0x06, 0x44,
+	0x65, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6e, 0x69,
+	0x61, 0x6c, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
+	0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x06, 0x12, 0x15, 0x0a,
+	0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x10, 0x0a, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d,
+	0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x10, 0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4c,
+	0x4f, 0x53, 0x53, 0x10, 0x0f, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45,
+	0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x10, 0x22, 0x7d, 0x0a, 0x0a, 0x54, 0x69,
+	0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x40, 0x0a, 0x07, 0x52, 0x75, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x09, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x22, 0x84, 0x03, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x52, 0x09,
+	0x72, 0x6f, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x61,
+	0x73, 0x65, 0x64, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x32, 0x0a, 0x0b, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x41, 0x75,
 	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x48, 0x00, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x22, 0x7d, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x52, 0x09, 0x72,
-	0x6f, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x61, 0x73,
-	0x65, 0x64, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x64, 0x2a,
-	0x28, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10,
-	0x0a, 0x0c, 0x41, 0x54, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x45, 0x10, 0x00,
-	0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x3a, 0x53, 0x0a, 0x0d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x86, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65,
-	0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x50,
-	0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x41,
+	0x75, 0x74, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x41, 0x75, 0x74, 0x68, 0x41, 0x67, 0x65, 0x22, 0x1b, 0x0a, 0x07, 0x43, 0x6f,
+	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x01, 0x22, 0x38, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x2a, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x54, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x54, 0x5f, 0x4c, 0x45, 0x41,
+	0x53, 0x54, 0x10, 0x03, 0x2a, 0x31, 0x0a, 0x07, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x53, 0x45, 0x43, 0x54, 0x10, 0x02, 0x2a, 0x7e, 0x0a, 0x07, 0x57, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
+	0x4f, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x55, 0x45, 0x53, 0x44,
+	0x41, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x45, 0x44, 0x4e, 0x45, 0x53, 0x44, 0x41,
+	0x59, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x48, 0x55, 0x52, 0x53, 0x44, 0x41, 0x59, 0x10,
+	0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x52, 0x49, 0x44, 0x41, 0x59, 0x10, 0x05, 0x12, 0x0c, 0x0a,
+	0x08, 0x53, 0x41, 0x54, 0x55, 0x52, 0x44, 0x41, 0x59, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x55, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x07, 0x3a, 0x5d, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd5, 0x86, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x65, 0x48, 0x69, 0x65,
+	0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x3a, 0x4a, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xd8, 0x86, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x3a, 0x49, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x12, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x86, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x3a, 0x53, 0x0a,
+	0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd2,
-	0x86, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52,
-	0x75, 0x6c, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x3b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xd1, 0x86, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x3a, 0x5c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x65,
+	0x6e, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd6, 0x86, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x3a, 0x6f, 0x0a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdc, 0x86, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x50, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xd2, 0x86, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x3a, 0x59, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x64, 0x65,
+	0x6e, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd7, 0x86, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x62,
+	0x0a, 0x14, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x69,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xda, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x52, 0x12,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x3a, 0x54, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x64, 0x65, 0x6e,
+	0x69, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xdb, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x3a, 0x6c, 0x0a, 0x15, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xdd, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x14, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x4d, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd3, 0x86, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x58, 0x0a, 0x11, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd4, 0x86, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42,
+	0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61,
+	0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x3b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -430,32 +2003,88 @@ func file_proto_guard_proto_rawDescGZIP() []byte {
 	return file_proto_guard_proto_rawDescData
 }
 
-var file_proto_guard_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_guard_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_guard_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_guard_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_proto_guard_proto_goTypes = []interface{}{
 	(Requirement)(0),                    // 0: guard.Requirement
-	(*RoleBased)(nil),                   // 1: guard.RoleBased
-	(*PolicyBased)(nil),                 // 2: guard.PolicyBased
-	(*Rule)(nil),                        // 3: guard.Rule
-	(*AuthenticatedAccess)(nil),         // 4: guard.AuthenticatedAccess
-	(*descriptorpb.ServiceOptions)(nil), // 5: google.protobuf.ServiceOptions
-	(*descriptorpb.MethodOptions)(nil),  // 6: google.protobuf.MethodOptions
+	(Inherit)(0),                        // 1: guard.Inherit
+	(Weekday)(0),                        // 2: guard.Weekday
+	(Denial_Code)(0),                    // 3: guard.Denial.Code
+	(AuthenticatedAccess_Combine)(0),    // 4: guard.AuthenticatedAccess.Combine
+	(*RoleBased)(nil),                   // 5: guard.RoleBased
+	(*ScopeBased)(nil),                  // 6: guard.ScopeBased
+	(*PolicyBased)(nil),                 // 7: guard.PolicyBased
+	(*PolicyReference)(nil),             // 8: guard.PolicyReference
+	(*PolicyArgument)(nil),              // 9: guard.PolicyArgument
+	(*Rule)(nil),                        // 10: guard.Rule
+	(*MetadataMatch)(nil),               // 11: guard.MetadataMatch
+	(*Workload)(nil),                    // 12: guard.Workload
+	(*Network)(nil),                     // 13: guard.Network
+	(*Denial)(nil),                      // 14: guard.Denial
+	(*TimeWindow)(nil),                  // 15: guard.TimeWindow
+	(*RuleSet)(nil),                     // 16: guard.RuleSet
+	(*Ownership)(nil),                   // 17: guard.Ownership
+	(*AuthenticatedAccess)(nil),         // 18: guard.AuthenticatedAccess
+	(*Authentication)(nil),              // 19: guard.Authentication
+	(*RoleInheritance)(nil),             // 20: guard.RoleInheritance
+	nil,                                 // 21: guard.PolicyReference.ArgsEntry
+	(*descriptorpb.FileOptions)(nil),    // 22: google.protobuf.FileOptions
+	(*descriptorpb.ServiceOptions)(nil), // 23: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 24: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),   // 25: google.protobuf.FieldOptions
 }
 var file_proto_guard_proto_depIdxs = []int32{
-	0, // 0: guard.RoleBased.requirement:type_name -> guard.Requirement
-	0, // 1: guard.PolicyBased.requirement:type_name -> guard.Requirement
-	4, // 2: guard.Rule.authenticated_access:type_name -> guard.AuthenticatedAccess
-	1, // 3: guard.AuthenticatedAccess.role_based:type_name -> guard.RoleBased
-	2, // 4: guard.AuthenticatedAccess.policy_based:type_name -> guard.PolicyBased
-	5, // 5: guard.service_rules:extendee -> google.protobuf.ServiceOptions
-	6, // 6: guard.method_rules:extendee -> google.protobuf.MethodOptions
-	3, // 7: guard.service_rules:type_name -> guard.Rule
-	3, // 8: guard.method_rules:type_name -> guard.Rule
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	7, // [7:9] is the sub-list for extension type_name
-	5, // [5:7] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: guard.RoleBased.requirement:type_name -> guard.Requirement
+	0,  // 1: guard.ScopeBased.requirement:type_name -> guard.Requirement
+	0,  // 2: guard.PolicyBased.requirement:type_name -> guard.Requirement
+	8,  // 3: guard.PolicyBased.references:type_name -> guard.PolicyReference
+	21, // 4: guard.PolicyReference.args:type_name -> guard.PolicyReference.ArgsEntry
+	18, // 5: guard.Rule.authenticated_access:type_name -> guard.AuthenticatedAccess
+	12, // 6: guard.Rule.workload:type_name -> guard.Workload
+	15, // 7: guard.Rule.windows:type_name -> guard.TimeWindow
+	14, // 8: guard.Rule.denial:type_name -> guard.Denial
+	13, // 9: guard.Rule.network:type_name -> guard.Network
+	11, // 10: guard.Rule.metadata:type_name -> guard.MetadataMatch
+	3,  // 11: guard.Denial.code:type_name -> guard.Denial.Code
+	2,  // 12: guard.TimeWindow.weekdays:type_name -> guard.Weekday
+	10, // 13: guard.RuleSet.rules:type_name -> guard.Rule
+	5,  // 14: guard.AuthenticatedAccess.role_based:type_name -> guard.RoleBased
+	7,  // 15: guard.AuthenticatedAccess.policy_based:type_name -> guard.PolicyBased
+	17, // 16: guard.AuthenticatedAccess.ownership:type_name -> guard.Ownership
+	6,  // 17: guard.AuthenticatedAccess.scope_based:type_name -> guard.ScopeBased
+	4,  // 18: guard.AuthenticatedAccess.combine:type_name -> guard.AuthenticatedAccess.Combine
+	9,  // 19: guard.PolicyReference.ArgsEntry.value:type_name -> guard.PolicyArgument
+	22, // 20: guard.role_hierarchy:extendee -> google.protobuf.FileOptions
+	22, // 21: guard.file_rules:extendee -> google.protobuf.FileOptions
+	22, // 22: guard.rule_set:extendee -> google.protobuf.FileOptions
+	23, // 23: guard.service_rules:extendee -> google.protobuf.ServiceOptions
+	23, // 24: guard.service_deny_rules:extendee -> google.protobuf.ServiceOptions
+	23, // 25: guard.service_authentication:extendee -> google.protobuf.ServiceOptions
+	24, // 26: guard.method_rules:extendee -> google.protobuf.MethodOptions
+	24, // 27: guard.method_deny_rules:extendee -> google.protobuf.MethodOptions
+	24, // 28: guard.method_rules_inherit:extendee -> google.protobuf.MethodOptions
+	24, // 29: guard.method_denial:extendee -> google.protobuf.MethodOptions
+	24, // 30: guard.method_authentication:extendee -> google.protobuf.MethodOptions
+	25, // 31: guard.field_rules:extendee -> google.protobuf.FieldOptions
+	25, // 32: guard.field_write_rules:extendee -> google.protobuf.FieldOptions
+	20, // 33: guard.role_hierarchy:type_name -> guard.RoleInheritance
+	10, // 34: guard.file_rules:type_name -> guard.Rule
+	16, // 35: guard.rule_set:type_name -> guard.RuleSet
+	10, // 36: guard.service_rules:type_name -> guard.Rule
+	10, // 37: guard.service_deny_rules:type_name -> guard.Rule
+	19, // 38: guard.service_authentication:type_name -> guard.Authentication
+	10, // 39: guard.method_rules:type_name -> guard.Rule
+	10, // 40: guard.method_deny_rules:type_name -> guard.Rule
+	1,  // 41: guard.method_rules_inherit:type_name -> guard.Inherit
+	14, // 42: guard.method_denial:type_name -> guard.Denial
+	19, // 43: guard.method_authentication:type_name -> guard.Authentication
+	10, // 44: guard.field_rules:type_name -> guard.Rule
+	10, // 45: guard.field_write_rules:type_name -> guard.Rule
+	46, // [46:46] is the sub-list for method output_type
+	46, // [46:46] is the sub-list for method input_type
+	33, // [33:46] is the sub-list for extension type_name
+	20, // [20:33] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_proto_guard_proto_init() }
@@ -477,7 +2106,7 @@ func file_proto_guard_proto_init() {
 			}
 		}
 		file_proto_guard_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyBased); i {
+			switch v := v.(*ScopeBased); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -489,7 +2118,7 @@ func file_proto_guard_proto_init() {
 			}
 		}
 		file_proto_guard_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rule); i {
+			switch v := v.(*PolicyBased); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -501,6 +2130,126 @@ func file_proto_guard_proto_init() {
 			}
 		}
 		file_proto_guard_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyArgument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Workload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Network); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Denial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ownership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthenticatedAccess); i {
 			case 0:
 				return &v.state
@@ -512,22 +2261,55 @@ func file_proto_guard_proto_init() {
 				return nil
 			}
 		}
+		file_proto_guard_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_guard_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleInheritance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_proto_guard_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_proto_guard_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	file_proto_guard_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_proto_guard_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_proto_guard_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*PolicyArgument_StringValue)(nil),
+		(*PolicyArgument_NumberValue)(nil),
+		(*PolicyArgument_BoolValue)(nil),
+	}
+	file_proto_guard_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*Rule_AllowPublic)(nil),
 		(*Rule_RequireAuthentication)(nil),
 		(*Rule_AuthenticatedAccess)(nil),
+		(*Rule_Condition)(nil),
+		(*Rule_Use)(nil),
+		(*Rule_Workload)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_guard_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   4,
-			NumExtensions: 2,
+			NumEnums:      5,
+			NumMessages:   17,
+			NumExtensions: 13,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_guard_proto_goTypes,